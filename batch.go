@@ -0,0 +1,123 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	"golang.org/x/net/html"
+)
+
+// Input is a single page that will be processed in batch extraction. Either `Reader`
+// or `Document` must be specified. If both specified, `Document` will be used.
+type Input struct {
+	// ID is used to identify the page in output, since the outputs are not sent in
+	// the same order as the inputs.
+	ID string
+	// Reader is the source of HTML page. It will not be closed by the extractor.
+	Reader io.Reader
+	// Document is the parsed HTML page.
+	Document *html.Node
+	// URL is the URL of the page. If empty, the URL from extractor's options is used.
+	URL string
+}
+
+// Output is the extraction result for a single page in batch extraction.
+type Output struct {
+	ID     string
+	Result Result
+	Err    error
+}
+
+// ExtractBatch extracts publish date from every inputs using a pool of workers. If
+// `workers` is not positive, the number of CPU will be used instead. The output
+// channel is closed once all inputs are processed, i.e. after the input channel is
+// closed and drained, or when the context is done. Each page is limited by the
+// `Timeout` in options, and panic while processing a page will be returned as error
+// for that page instead of crashing the whole batch.
+func (e *Extractor) ExtractBatch(ctx context.Context, inputs <-chan Input, workers int) <-chan Output {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	var wg sync.WaitGroup
+	outputs := make(chan Output, workers)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				var input Input
+				var ok bool
+
+				select {
+				case <-ctx.Done():
+					return
+				case input, ok = <-inputs:
+					if !ok {
+						return
+					}
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case outputs <- e.extractInput(ctx, input):
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(outputs)
+	}()
+
+	return outputs
+}
+
+// extractInput extracts publish date from a single input in batch extraction.
+func (e *Extractor) extractInput(ctx context.Context, input Input) (output Output) {
+	output.ID = input.ID
+
+	defer func() {
+		if r := recover(); r != nil {
+			output.Result = resultZero
+			output.Err = fmt.Errorf("panic while extracting %q: %v", input.ID, r)
+		}
+	}()
+
+	extractor := e
+	if input.URL != "" {
+		extractor = e.WithURL(input.URL)
+	}
+
+	switch {
+	case input.Document != nil:
+		output.Result, output.Err = extractor.ExtractDocument(ctx, input.Document)
+	case input.Reader != nil:
+		output.Result, output.Err = extractor.Extract(ctx, input.Reader)
+	default:
+		output.Err = fmt.Errorf("input %q has no document", input.ID)
+	}
+
+	return
+}
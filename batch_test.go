@@ -0,0 +1,73 @@
+package htmldate
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ExtractBatch(t *testing.T) {
+	urls := []string{
+		"http://blog.python.org/2016/12/python-360-is-now-available.html",
+		"http://blog.kinra.de/?p=959/",
+		"http://carta.info/der-neue-trend-muss-statt-wunschkoalition/",
+		"https://futurezone.at/digital-life/wie-creativecommons-richtig-genutzt-wird/24.600.504",
+	}
+
+	e, err := NewExtractor(Options{})
+	assert.NoError(t, err)
+
+	// Send the inputs, including the invalid ones
+	inputs := make(chan Input)
+	go func() {
+		defer close(inputs)
+		for _, url := range urls {
+			f := openMockFile(url)
+			content, _ := io.ReadAll(f)
+			f.Close()
+
+			inputs <- Input{ID: url, Reader: bytes.NewReader(content), URL: url}
+		}
+
+		inputs <- Input{ID: "empty"}
+		inputs <- Input{ID: "panic", Reader: panicReader{}}
+		inputs <- Input{ID: "string", Reader: strings.NewReader(`<html><head>
+			<meta property="article:published_time" content="2017-09-01"/>
+			</head><body></body></html>`)}
+	}()
+
+	// Collect the outputs
+	outputs := make(map[string]Output)
+	for output := range e.ExtractBatch(context.Background(), inputs, 3) {
+		outputs[output.ID] = output
+	}
+
+	assert.Len(t, outputs, len(urls)+3)
+	for _, url := range urls {
+		assert.NoError(t, outputs[url].Err)
+		assert.Equal(t, extractMockFile(url), outputs[url].Result)
+	}
+
+	assert.Error(t, outputs["empty"].Err)
+	assert.ErrorContains(t, outputs["panic"].Err, "panic")
+	assert.NoError(t, outputs["string"].Err)
+	assert.Equal(t, "2017-09-01", outputs["string"].Result.DateTime.Format("2006-01-02"))
+
+	// Canceled context should close the output channel
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	nCanceled := 0
+	for range e.ExtractBatch(ctx, make(chan Input), 0) {
+		nCanceled++
+	}
+	assert.Zero(t, nCanceled)
+}
+
+type panicReader struct{}
+
+func (panicReader) Read([]byte) (int, error) { panic("unexpected read") }
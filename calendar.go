@@ -0,0 +1,340 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hablullah/go-hijri"
+	"github.com/jalaali/go-jalaali"
+)
+
+// Calendar is the calendar system that used to write a date.
+type Calendar int
+
+const (
+	CalendarGregorian Calendar = iota
+	CalendarHijri              // Islamic calendar, using Umm al-Qura system
+	CalendarJalali             // Persian solar calendar
+	CalendarBuddhist           // Thai solar calendar with Buddhist era
+	CalendarJapanese           // Gregorian calendar with Japanese era
+)
+
+var calendarNames = map[Calendar]string{
+	CalendarGregorian: "gregorian",
+	CalendarHijri:     "hijri",
+	CalendarJalali:    "jalali",
+	CalendarBuddhist:  "buddhist",
+	CalendarJapanese:  "japanese",
+}
+
+// String returns the name of calendar system.
+func (c Calendar) String() string {
+	if name, exist := calendarNames[c]; exist {
+		return name
+	}
+	return calendarNames[CalendarGregorian]
+}
+
+// calendarPattern is pattern for a date in non-Gregorian calendar.
+type calendarPattern struct {
+	calendar Calendar
+	rx       *regexp.Regexp
+	order    string                     // order of the captured date parts, e.g. "dmy"
+	months   map[string]int             // month names, nil if month is written as number
+	accept   func(string, Options) bool // additional check for ambiguous pattern
+}
+
+var (
+	thaiMonths = monthNamesMap([12][]string{
+		{"มกราคม", "ม.ค."},
+		{"กุมภาพันธ์", "ก.พ."},
+		{"มีนาคม", "มี.ค."},
+		{"เมษายน", "เม.ย."},
+		{"พฤษภาคม", "พ.ค."},
+		{"มิถุนายน", "มิ.ย."},
+		{"กรกฎาคม", "ก.ค."},
+		{"สิงหาคม", "ส.ค."},
+		{"กันยายน", "ก.ย."},
+		{"ตุลาคม", "ต.ค."},
+		{"พฤศจิกายน", "พ.ย."},
+		{"ธันวาคม", "ธ.ค."},
+	})
+
+	persianMonths = monthNamesMap([12][]string{
+		{"فروردین"},
+		{"اردیبهشت"},
+		{"خرداد"},
+		{"تیر"},
+		{"مرداد", "امرداد"},
+		{"شهریور"},
+		{"مهر"},
+		{"آبان"},
+		{"آذر"},
+		{"دی"},
+		{"بهمن"},
+		{"اسفند"},
+	})
+
+	hijriMonths = monthNamesMap([12][]string{
+		{"محرم"},
+		{"صفر"},
+		{"ربيع الأول", "ربيع الاول"},
+		{"ربيع الآخر", "ربيع الاخر", "ربيع الثاني"},
+		{"جمادى الأولى", "جمادى الاولى", "جمادي الأولى", "جمادي الاولى"},
+		{"جمادى الآخرة", "جمادى الاخرة", "جمادى الثانية", "جمادي الآخرة", "جمادي الثانية"},
+		{"رجب"},
+		{"شعبان"},
+		{"رمضان"},
+		{"شوال"},
+		{"ذو القعدة", "ذي القعدة"},
+		{"ذو الحجة", "ذي الحجة"},
+	})
+
+	// hijriMarker is the era marker that required after numeric Hijri date, i.e. "هـ" or
+	// its short form "ه", since the numbers alone might be any other date.
+	hijriMarker = `(?:هـ|ه)(?:\PL|$)`
+
+	rxJapaneseEra = regexp.MustCompile(`(令和|平成|昭和)\s*(元|\d{1,2})\s*年\s*(\d{1,2})\s*月(?:\s*(\d{1,2})\s*(?:日|$))?`)
+
+	calendarPatterns = []calendarPattern{{
+		calendar: CalendarBuddhist,
+		rx:       compileRegexF(`(\d{1,2})\s*(%s)\s*(?:พ\.\s?ศ\.\s*)?(\d{4})`, monthNamesPattern(thaiMonths)),
+		order:    "dmy",
+		months:   thaiMonths,
+	}, {
+		calendar: CalendarBuddhist,
+		rx:       regexp.MustCompile(`(?:^|\D)(\d{1,2})[/.-](\d{1,2})[/.-](25\d{2})(?:\D|$)`),
+		order:    "dmy",
+		accept:   acceptScript("th", unicode.Thai),
+	}, {
+		calendar: CalendarJalali,
+		rx:       compileRegexF(`(\d{1,2})\s*(%s)\s*(\d{4})`, monthNamesPattern(persianMonths)),
+		order:    "dmy",
+		months:   persianMonths,
+	}, {
+		calendar: CalendarJalali,
+		rx:       regexp.MustCompile(`(?:^|\D)(1[34]\d{2})[/.-](\d{1,2})[/.-](\d{1,2})(?:\D|$)`),
+		order:    "ymd",
+		accept:   acceptScript("fa", extendedArabicDigits),
+	}, {
+		calendar: CalendarHijri,
+		rx:       compileRegexF(`(\d{1,2})\s*(%s)\s*(\d{4})`, monthNamesPattern(hijriMonths)),
+		order:    "dmy",
+		months:   hijriMonths,
+	}, {
+		calendar: CalendarHijri,
+		rx:       compileRegexF(`(?:^|\D)(1[34]\d{2})[/.-](\d{1,2})[/.-](\d{1,2})\s*%s`, hijriMarker),
+		order:    "ymd",
+	}, {
+		calendar: CalendarHijri,
+		rx:       compileRegexF(`(?:^|\D)(\d{1,2})[/.-](\d{1,2})[/.-](1[34]\d{2})\s*%s`, hijriMarker),
+		order:    "dmy",
+	}}
+)
+
+// calendarLanguages is the languages where numeric date is written in non-Gregorian
+// calendar, i.e. Persian (Jalali) and Thai (Buddhist era).
+var calendarLanguages = sliceToMap("fa", "th")
+
+// extendedArabicDigits is the digits used in Persian and Urdu, e.g. ۱۴۰۰.
+var extendedArabicDigits = &unicode.RangeTable{
+	R16: []unicode.Range16{{Lo: 0x06F0, Hi: 0x06F9, Stride: 1}},
+}
+
+// calendarParse looks for date that written in non-Gregorian calendar and converts
+// it into Gregorian date.
+func calendarParse(s string, opts Options) (time.Time, Calendar) {
+	// Non-Gregorian dates are written using non-ASCII characters, unless the page
+	// language uses numeric date in other calendar
+	if !hasNonASCII(s) && !slices.ContainsFunc(opts.languages, isCalendarLanguage) {
+		return timeZero, CalendarGregorian
+	}
+
+	original := s
+	s = normalizeDigits(s)
+
+	// Japanese era
+	if parts := rxJapaneseEra.FindStringSubmatch(s); len(parts) > 0 {
+		if dt, valid := japaneseEraToGregorian(parts[1:], opts); valid {
+			return dt, CalendarJapanese
+		}
+	}
+
+	// Other calendars
+	for _, pattern := range calendarPatterns {
+		if pattern.accept != nil && !pattern.accept(original, opts) {
+			continue
+		}
+
+		for _, parts := range pattern.rx.FindAllStringSubmatch(s, -1) {
+			var year, month, day int
+			for i, part := range pattern.order {
+				value := parts[i+1]
+				switch part {
+				case 'y':
+					year, _ = strconv.Atoi(value)
+				case 'd':
+					day, _ = strconv.Atoi(value)
+				case 'm':
+					if pattern.months != nil {
+						month = pattern.months[value]
+					} else {
+						month, _ = strconv.Atoi(value)
+					}
+				}
+			}
+
+			if dt, valid := toGregorian(pattern.calendar, year, month, day, opts); valid {
+				return dt, pattern.calendar
+			}
+		}
+	}
+
+	return timeZero, CalendarGregorian
+}
+
+// toGregorian converts the date parts in the specified calendar into Gregorian date.
+func toGregorian(calendar Calendar, year, month, day int, opts Options) (time.Time, bool) {
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return timeZero, false
+	}
+
+	switch calendar {
+	case CalendarBuddhist:
+		return validateDateParts(year-543, month, day, opts)
+
+	case CalendarJalali:
+		if !jalaali.IsValidDate(year, month, day) {
+			return timeZero, false
+		}
+
+		gy, gm, gd, err := jalaali.ToGregorian(year, jalaali.Month(month), day)
+		if err != nil {
+			return timeZero, false
+		}
+		return validateDateParts(gy, int(gm), gd, opts)
+
+	case CalendarHijri:
+		// Umm al-Qura only covers 1356 H until 1500 H
+		if year <= 1356 || year >= 1500 || day > 30 {
+			return timeZero, false
+		}
+
+		date := hijri.UmmAlQuraDate{Year: int64(year), Month: int64(month), Day: int64(day)}
+		dt := date.ToGregorian()
+
+		// Make sure the day exists in the month, e.g. not 30 in 29 days month
+		check, err := hijri.CreateUmmAlQuraDate(dt)
+		if err != nil || check.Day != int64(day) {
+			return timeZero, false
+		}
+		return validateDateParts(dt.Year(), int(dt.Month()), dt.Day(), opts)
+	}
+
+	return timeZero, false
+}
+
+// japaneseEraToGregorian converts the captured era, year, month and optional day into
+// Gregorian date.
+func japaneseEraToGregorian(parts []string, opts Options) (time.Time, bool) {
+	var eraStart int
+	switch parts[0] {
+	case "令和":
+		eraStart = 2019
+	case "平成":
+		eraStart = 1989
+	case "昭和":
+		eraStart = 1926
+	}
+
+	// First year of an era is written as 元年
+	year := 1
+	if parts[1] != "元" {
+		year, _ = strconv.Atoi(parts[1])
+	}
+
+	month, _ := strconv.Atoi(parts[2])
+	day := 1
+	if parts[3] != "" {
+		day, _ = strconv.Atoi(parts[3])
+	}
+
+	return validateDateParts(eraStart+year-1, month, day, opts)
+}
+
+// acceptScript returns function that accepts a string when the page has the specified
+// language, or when the string contains characters from the specified script.
+func acceptScript(language string, script *unicode.RangeTable) func(string, Options) bool {
+	return func(s string, opts Options) bool {
+		if slices.Contains(opts.languages, language) {
+			return true
+		}
+
+		return strings.ContainsFunc(s, func(r rune) bool {
+			return unicode.Is(script, r)
+		})
+	}
+}
+
+// monthNamesMap creates map of month name to its number.
+func monthNamesMap(months [12][]string) map[string]int {
+	names := make(map[string]int)
+	for i, monthNames := range months {
+		for _, name := range monthNames {
+			names[name] = i + 1
+		}
+	}
+	return names
+}
+
+// monthNamesPattern creates regex alternation for the month names, with the longest
+// names first so they are preferred.
+func monthNamesPattern(months map[string]int) string {
+	var names []string
+	for name := range months {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+
+	slices.SortFunc(names, func(a, b string) int {
+		if diff := utf8.RuneCountInString(b) - utf8.RuneCountInString(a); diff != 0 {
+			return diff
+		}
+		return strings.Compare(a, b)
+	})
+
+	return strings.Join(names, "|")
+}
+
+func isCalendarLanguage(language string) bool {
+	_, exist := calendarLanguages[language]
+	return exist
+}
+
+// hasNonASCII checks if the string contains any non-ASCII characters.
+func hasNonASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return true
+		}
+	}
+	return false
+}
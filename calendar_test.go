@@ -0,0 +1,108 @@
+package htmldate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_calendarParse(t *testing.T) {
+	opts := Options{
+		MinDate:   defaultMinDate,
+		MaxDate:   testMaxDate,
+		EnableLog: true,
+	}
+
+	parse := func(s string, languages ...string) (string, Calendar) {
+		opts := opts
+		opts.languages = languages
+		dt, calendar := calendarParse(s, opts)
+		if !dt.IsZero() {
+			return dt.Format("2006-01-02"), calendar
+		}
+		return "", calendar
+	}
+
+	check := func(expected string, calendar Calendar, s string, languages ...string) {
+		result, resultCalendar := parse(s, languages...)
+		assert.Equal(t, expected, result, s)
+		assert.Equal(t, calendar, resultCalendar, s)
+	}
+
+	// Japanese era
+	check("2021-07-13", CalendarJapanese, "令和3年7月13日")
+	check("2021-07-13", CalendarJapanese, "令和３年７月１３日")
+	check("2019-05-01", CalendarJapanese, "令和元年5月1日")
+	check("2019-04-30", CalendarJapanese, "平成31年4月30日")
+	check("2004-12-01", CalendarJapanese, "平成16年12月")
+	check("", CalendarGregorian, "平成16年13月1日")
+
+	// Thai Buddhist
+	check("2021-07-13", CalendarBuddhist, "13 กรกฎาคม 2564")
+	check("2021-07-13", CalendarBuddhist, "วันที่ 13 ก.ค. 2564")
+	check("2021-07-13", CalendarBuddhist, "๑๓ ก.ค. พ.ศ. ๒๕๖๔")
+	check("2021-07-13", CalendarBuddhist, "๑๓/๐๗/๒๕๖๔")
+	check("2021-07-13", CalendarBuddhist, "13/07/2564", "th")
+	check("", CalendarGregorian, "13/07/2564")
+
+	// Jalali
+	check("2021-07-13", CalendarJalali, "۲۲ تیر ۱۴۰۰")
+	check("2021-07-13", CalendarJalali, "۱۴۰۰/۰۴/۲۲")
+	check("2021-07-13", CalendarJalali, "1400/04/22", "fa")
+	check("", CalendarGregorian, "۱۴۰۰/۱۳/۲۲")
+
+	// Hijri
+	check("2021-07-13", CalendarHijri, "3 ذو الحجة 1442")
+	check("2021-07-13", CalendarHijri, "٣ ذي الحجة ١٤٤٢ هـ")
+	check("2021-07-13", CalendarHijri, "1442/12/03 هـ")
+	check("2021-07-13", CalendarHijri, "03/12/1442هـ")
+	check("2021-07-13", CalendarHijri, "1442/12/03 ه")
+	check("", CalendarGregorian, "١٤٤٢/١٢/٠٣", "ar")
+	check("", CalendarGregorian, "نشر في 03/12/1442", "ar")
+	check("", CalendarGregorian, "1442/12/03 هجري", "ar")
+	check("", CalendarGregorian, "30 ذو الحجة 1442")
+
+	// Not a non-Gregorian date
+	check("", CalendarGregorian, "2021-07-13")
+	check("", CalendarGregorian, "1400/04/22")
+	check("", CalendarGregorian, "2021年7月13日")
+}
+
+func Test_Calendar(t *testing.T) {
+	opts := Options{ExtractTime: true}
+
+	check := func(expected string, calendar Calendar, htmlString string) {
+		result, err := FromReader(strings.NewReader(htmlString), opts)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result.Format("2006-01-02"), htmlString)
+		assert.Equal(t, calendar, result.Calendar, htmlString)
+	}
+
+	check("2021-07-13", CalendarJalali, `<html lang="fa"><body>
+		<span class="date">تاریخ انتشار: ۲۲ تیر ۱۴۰۰</span>
+	</body></html>`)
+	check("2021-07-13", CalendarHijri, `<html lang="ar"><body>
+		<span class="date">الثلاثاء 3 ذو الحجة 1442 هـ</span>
+	</body></html>`)
+	check("2021-07-13", CalendarBuddhist, `<html lang="th"><body>
+		<span class="date">13/07/2564</span>
+	</body></html>`)
+	check("2021-07-13", CalendarJapanese, `<html lang="ja"><body>
+		<span class="date">令和3年7月13日</span>
+	</body></html>`)
+	check("2021-07-13", CalendarGregorian, `<html><body>
+		<span class="date">2021-07-13</span>
+	</body></html>`)
+	check("2021-07-13", CalendarGregorian, `<html lang="ar"><body>
+		<span class="date">2021-07-13 (3 ذو الحجة 1442 هـ)</span>
+	</body></html>`)
+
+	// Persian digits in page text in fast mode
+	result, err := FromReader(strings.NewReader(`<html><body>
+		<p class="date">۱۴۰۰/۰۴/۲۲</p>
+	</body></html>`), Options{Languages: []string{"fa"}, SkipExtensiveSearch: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-07-13", result.Format("2006-01-02"))
+	assert.Equal(t, CalendarJalali, result.Calendar)
+}
@@ -0,0 +1,292 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+// Method is the extraction method that used to find a date.
+type Method int
+
+const (
+	MethodUnknown      Method = iota
+	MethodUrl                 // date in URL of the page
+	MethodMeta                // <meta> elements in document
+	MethodJson                // JSON-LD and settings JSON scripts
+	MethodAbbr                // <abbr> elements
+	MethodSelector            // elements that matched with date selectors
+	MethodTitle               // <title> and <h1> elements
+	MethodTime                // <time> elements
+	MethodTimestamp           // timestamp pattern in page HTML
+	MethodMetaImage           // URL of og:image
+	MethodIdiosyncrasy        // author-written date expression in page HTML
+	MethodFreeText            // extensive search in text nodes
+	MethodSearchPage          // opportunistic pattern search in page HTML
+	MethodCustom              // user-defined stage in pipeline
+	MethodMicrodata           // microdata and RDFa properties
+	MethodHydration           // hydration data of JavaScript frameworks
+	MethodAnalytics           // object literals in analytics snippets
+	MethodEpoch               // epoch timestamps in data attributes
+)
+
+var methodNames = map[Method]string{
+	MethodUnknown:      "unknown",
+	MethodUrl:          "url",
+	MethodMeta:         "meta",
+	MethodJson:         "json",
+	MethodAbbr:         "abbr",
+	MethodSelector:     "selector",
+	MethodTitle:        "title",
+	MethodTime:         "time",
+	MethodTimestamp:    "timestamp",
+	MethodMetaImage:    "meta-image",
+	MethodIdiosyncrasy: "idiosyncrasy",
+	MethodFreeText:     "free-text",
+	MethodSearchPage:   "search-page",
+	MethodCustom:       "custom",
+	MethodMicrodata:    "microdata",
+	MethodHydration:    "hydration",
+	MethodAnalytics:    "analytics",
+	MethodEpoch:        "epoch",
+}
+
+// methodScores is the base confidence for each method, used to score the candidates.
+// Structured metadata is trusted the most, while the opportunistic search is the least.
+var methodScores = map[Method]float64{
+	MethodUrl:          0.8,
+	MethodMeta:         0.9,
+	MethodJson:         0.9,
+	MethodAbbr:         0.7,
+	MethodSelector:     0.6,
+	MethodTitle:        0.5,
+	MethodTime:         0.7,
+	MethodTimestamp:    0.5,
+	MethodMetaImage:    0.4,
+	MethodIdiosyncrasy: 0.4,
+	MethodFreeText:     0.3,
+	MethodSearchPage:   0.2,
+	MethodCustom:       0.5,
+	MethodMicrodata:    0.8,
+	MethodHydration:    0.7,
+	MethodAnalytics:    0.6,
+	MethodEpoch:        0.6,
+}
+
+// String returns the name of extraction method.
+func (m Method) String() string {
+	if name, exist := methodNames[m]; exist {
+		return name
+	}
+	return methodNames[MethodUnknown]
+}
+
+// Candidate is a date that found by one of the extraction methods. Unlike `Result`
+// which only contains the winner, candidates contain every date that found in the
+// document, including the runner-ups.
+type Candidate struct {
+	Result
+
+	// Node is the node where the date found. It points to the copy of the document
+	// that used during extraction, and nil if date is not found in node (e.g. in URL).
+	Node *html.Node
+	// Score is the confidence of this candidate, between 0 and 1.
+	Score float64
+}
+
+// candidateSource describes where the next found date is coming from.
+type candidateSource struct {
+	method    Method
+	node      *html.Node
+	attribute string
+	weight    float64
+	relative  bool
+
+	entityType string
+	entityID   string
+	calendar   Calendar
+}
+
+// candidateSet collects the candidates found during a single extraction.
+type candidateSet struct {
+	items      []candidateItem
+	exhaustive bool
+}
+
+// candidateItem is a date that recorded from a source. Its result is only created when
+// the candidates are returned, since most of them are never used.
+type candidateItem struct {
+	rawString string
+	date      time.Time
+	source    candidateSource
+	opts      Options
+}
+
+// from returns copy of options where the found date will be recorded as coming from
+// the specified source. Only used when the candidates are collected.
+func (opts Options) from(method Method, node *html.Node, attribute string) Options {
+	if opts.candidates != nil {
+		opts.source = candidateSource{
+			method:    method,
+			node:      node,
+			attribute: attribute,
+			weight:    1,
+		}
+	}
+	return opts
+}
+
+// exhaustive reports whether all candidates are being collected, in which case the
+// extractors should keep looking after they found a date.
+func (opts Options) exhaustive() bool {
+	return opts.candidates != nil && opts.candidates.exhaustive
+}
+
+// asReserve returns copy of options where the found date is marked as reserve, i.e.
+// only used when nothing better is found, so its score is lowered.
+func (opts Options) asReserve() Options {
+	opts.source.weight = 0.5
+	return opts
+}
+
+// asRelative returns copy of options where the found date is marked as resolved from
+// relative expression, so its score is lowered.
+func (opts Options) asRelative() Options {
+	opts.source.weight = 0.5
+	opts.source.relative = true
+	return opts
+}
+
+// inEntity returns copy of options where the found date is marked as coming from the
+// JSON-LD entity with the specified type and @id.
+func (opts Options) inEntity(entityType, entityID string) Options {
+	opts.source.entityType = entityType
+	opts.source.entityID = entityID
+	return opts
+}
+
+// inCalendar returns copy of options where the found date is marked as converted from
+// the specified calendar.
+func (opts Options) inCalendar(calendar Calendar) Options {
+	opts.source.calendar = calendar
+	return opts
+}
+
+// record saves the date found from the current source as candidate.
+func (opts Options) record(rawString string, date time.Time) {
+	if opts.candidates == nil || opts.source.method == MethodUnknown || date.IsZero() {
+		return
+	}
+
+	opts.candidates.items = append(opts.candidates.items, candidateItem{
+		rawString: rawString,
+		date:      date,
+		source:    opts.source,
+		opts:      opts,
+	})
+}
+
+// candidate creates the result of the recorded date and returns it as candidate.
+func (item candidateItem) candidate() Candidate {
+	src := item.source
+	result := createResult(item.rawString, item.date, item.opts)
+	result.Method = src.method
+	result.Attribute = src.attribute
+	result.ElementPath = elementPath(src.node)
+	result.Relative = src.relative
+	result.EntityType = src.entityType
+	result.EntityID = src.entityID
+	result.Calendar = src.calendar
+
+	return Candidate{
+		Result: result,
+		Node:   src.node,
+		Score:  methodScores[src.method] * src.weight,
+	}
+}
+
+// find returns the source of the first candidate with the specified method and date.
+// Candidate that resolved from absolute date is preferred over the relative one.
+func (cs *candidateSet) find(method Method, date time.Time) (candidateSource, bool) {
+	if cs == nil {
+		return candidateSource{}, false
+	}
+
+	var relative *candidateSource
+	for i, item := range cs.items {
+		if item.source.method != method || !sameDate(item.date, date) {
+			continue
+		}
+
+		if !item.source.relative {
+			return item.source, true
+		} else if relative == nil {
+			relative = &cs.items[i].source
+		}
+	}
+
+	if relative != nil {
+		return *relative, true
+	}
+	return candidateSource{}, false
+}
+
+// sorted returns the candidates sorted by their score. Candidates with the same score
+// are kept in the order they are found.
+func (cs *candidateSet) sorted() []Candidate {
+	candidates := make([]Candidate, len(cs.items))
+	for i, item := range cs.items {
+		candidates[i] = item.candidate()
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].Score > candidates[b].Score
+	})
+	return candidates
+}
+
+// elementPath returns the path of the element from the document root, where each
+// element is described by its tag name, id and classes.
+func elementPath(node *html.Node) string {
+	var parts []string
+	for ; node != nil; node = node.Parent {
+		if node.Type != html.ElementNode {
+			continue
+		}
+
+		part := dom.TagName(node)
+		if id := strings.TrimSpace(dom.ID(node)); id != "" {
+			part += "#" + id
+		} else if classes := strings.Fields(dom.ClassName(node)); len(classes) > 0 {
+			part += "." + strings.Join(classes, ".")
+		}
+
+		parts = append([]string{part}, parts...)
+	}
+
+	return strings.Join(parts, " > ")
+}
+
+// sameDate check if both time has the same date.
+func sameDate(a, b time.Time) bool {
+	y1, m1, d1 := a.Date()
+	y2, m2, d2 := b.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
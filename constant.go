@@ -31,7 +31,6 @@ type fnRe2GoFinder func(string) [][]int
 var (
 	timeZero       = time.Time{}
 	defaultMinDate = time.Date(1995, 1, 1, 0, 0, 0, 0, time.UTC)
-	defaultMaxDate = time.Now().AddDate(1, 0, 0)
 
 	externalParser = &dps.Parser{
 		ParserTypes: []dps.ParserType{
@@ -40,6 +39,12 @@ var (
 		},
 	}
 
+	relativeParser = &dps.Parser{
+		ParserTypes: []dps.ParserType{
+			dps.RelativeTime,
+		},
+	}
+
 	externalDpsConfig = &dps.Configuration{
 		// DateOrder:           dps.DMY,
 		// PreferredDayOfMonth: dps.First,
@@ -51,12 +56,13 @@ var (
 const (
 	minSegmentLen         = 6
 	maxSegmentLen         = 52
+	maxRelativeLen        = 30
 	maxPossibleCandidates = 1_000
 	defaultDateFormat     = "2006-1-2"
 )
 
 var (
-	rxLastNonDigits = regexp.MustCompile(`\D+$`)
+	rxLastNonDigits = regexp.MustCompile(`\P{Nd}+$`)
 
 	rxDay   = `[0-3]?[0-9]`
 	rxMonth = `[0-1]?[0-9]`
@@ -120,23 +126,88 @@ var (
 	rxIsoTime    = regexp.MustCompile(`(?i)(\d{2}):(\d{2})(?::(\d{2})(?:\.\d+)?)?(Z|[+-]\d{2}(?::?\d{2})?)`)
 
 	rxLastJsonBracket = regexp.MustCompile(`(?i)\s*\}$`)
+
+	// CJK patterns, e.g. 2021年7月13日, 2021년 7월 13일 and 19時25分
+	rxCjkDate  = regexp.MustCompile(`(\d{4})\s*[年년]\s*(\d{1,2})\s*[月월](?:\s*(\d{1,2})\s*(?:[日일]|$))?`)
+	rxCjkCatch = regexp.MustCompile(`(\d{4})\s*[年년]\s*(\d{1,2})\s*[月월]\s*(\d{1,2})\s*[日일]`)
+	rxCjkTime  = regexp.MustCompile(`(午前|午後|上午|下午|오전|오후)?\s*(\d{1,2})\s*[時时시]\s*(\d{1,2})\s*[分분](?:\s*(\d{1,2})\s*[秒초])?`)
+
+	// Roman numeral month patterns, e.g. 13.VII.2021, 13 VII 2021 and 2021. VII. 13.
+	rxRomanMonth = `XII|XI|X|IX|VIII|VII|VI|V|IV|III|II|I`
+	rxRomanSep   = `(?:\s*[./-]\s*|\s+)`
+	rxRomanDMY   = compileRegexF(`(?:^|\D)((%[1]s)%[2]s(%[3]s)%[2]s(%[4]s))(?:\D|$)`,
+		rxDay, rxRomanSep, rxRomanMonth, rxYear)
+	rxRomanYMD = compileRegexF(`(?:^|\D)((%[4]s)%[2]s(%[3]s)%[2]s(%[1]s))(?:\D|$)`,
+		rxDay, rxRomanSep, rxRomanMonth, rxYear)
+	rxRomanYear = compileRegexF(`(?:^|\D)(%s)(?:\D|$)`, rxYear)
 )
 
-// English, French, German, Indonesian and Turkish dates cache
+var romanMonths = map[string]int{
+	"I": 1, "II": 2, "III": 3, "IV": 4, "V": 5, "VI": 6,
+	"VII": 7, "VIII": 8, "IX": 9, "X": 10, "XI": 11, "XII": 12,
+}
+
+// Month names of the long text pattern, grouped by month number
 var monthNumber = func() map[string]int {
 	var monthNames = [][]string{
-		{"jan", "januar", "jänner", "january", "januari", "janvier", "ocak", "oca"},
-		{"feb", "februar", "feber", "february", "februari", "février", "şubat", "şub"},
-		{"mar", "mär", "märz", "march", "maret", "mart", "mars"},
-		{"apr", "april", "avril", "nisan", "nis"},
-		{"may", "mai", "mei", "mayıs"},
-		{"jun", "juni", "june", "juin", "haziran", "haz"},
-		{"jul", "juli", "july", "juillet", "temmuz", "tem"},
-		{"aug", "august", "agustus", "ağustos", "ağu", "aout"},
-		{"sep", "september", "septembre", "eylül", "eyl"},
-		{"oct", "oktober", "october", "octobre", "okt", "ekim", "eki"},
-		{"nov", "november", "kasım", "kas", "novembre"},
-		{"dec", "dez", "dezember", "december", "desember", "décembre", "aralık", "ara"},
+		{"jan", "januar", "jänner", "january", "januari", "janvier", "ocak", "oca",
+			"enero", "ene", "gennaio", "gen", "janeiro", "janv",
+			"styczeń", "stycznia", "styczen", "sty", "leden", "ledna",
+			"январь", "января", "янв", "січень", "січня", "січ",
+			"január"},
+		{"feb", "februar", "feber", "february", "februari", "février", "şubat", "şub",
+			"febrero", "febbraio", "fevereiro", "fev", "févr", "fevr", "fév",
+			"luty", "lutego", "lut", "únor", "února", "unor", "unora", "úno",
+			"февраль", "февраля", "февр", "фев", "лютий", "лютого", "лют",
+			"február", "febr"},
+		{"mar", "mär", "märz", "march", "maret", "mart", "mars",
+			"marzo", "março", "marco", "maart", "mrt",
+			"marzec", "marca", "březen", "března", "brezen", "brezna", "bře",
+			"март", "марта", "мар", "березень", "березня", "бер",
+			"március", "marcius", "márc", "marc"},
+		{"apr", "april", "avril", "nisan", "nis",
+			"abril", "abr", "aprile", "avr",
+			"kwiecień", "kwietnia", "kwiecien", "kwi", "duben", "dubna",
+			"апрель", "апреля", "апр", "квітень", "квітня", "квіт", "кві",
+			"április", "aprilis", "ápr"},
+		{"may", "mai", "mei", "mayıs",
+			"mayo", "maggio", "mag", "maio",
+			"maj", "maja", "květen", "května", "kveten", "kvetna", "kvě",
+			"май", "мая", "травень", "травня", "трав", "тра",
+			"május", "majus", "máj"},
+		{"jun", "juni", "june", "juin", "haziran", "haz",
+			"junio", "giugno", "giu", "junho",
+			"czerwiec", "czerwca", "cze", "červen", "června", "cerven", "cervna", "čvn",
+			"июнь", "июня", "июн", "червень", "червня", "черв", "чер",
+			"június", "junius", "jún"},
+		{"jul", "juli", "july", "juillet", "temmuz", "tem",
+			"julio", "luglio", "lug", "julho", "juil",
+			"lipiec", "lipca", "lip", "červenec", "července", "cervenec", "cervence", "čvc",
+			"июль", "июля", "июл", "липень", "липня", "лип",
+			"július", "julius", "júl"},
+		{"aug", "august", "agustus", "ağustos", "ağu", "aout",
+			"agosto", "ago", "augustus",
+			"sierpień", "sierpnia", "sierpien", "sie", "srpen", "srpna", "srp",
+			"август", "августа", "авг", "серпень", "серпня", "серп", "сер",
+			"augusztus"},
+		{"sep", "september", "septembre", "eylül", "eyl",
+			"septiembre", "setiembre", "sept", "set", "settembre", "setembro",
+			"wrzesień", "września", "wrzesien", "wrzesnia", "wrz", "září", "zari", "zář",
+			"сентябрь", "сентября", "сент", "сен", "вересень", "вересня", "вер",
+			"szeptember", "szept", "szep"},
+		{"oct", "oktober", "october", "octobre", "okt", "ekim", "eki",
+			"octubre", "ottobre", "ott", "outubro", "out",
+			"październik", "października", "pazdziernik", "pazdziernika", "paź", "paz", "říjen", "října", "rijen", "rijna", "říj",
+			"октябрь", "октября", "окт", "жовтень", "жовтня", "жовт", "жов",
+			"október"},
+		{"nov", "november", "kasım", "kas", "novembre",
+			"noviembre", "novembro",
+			"listopad", "listopada", "lis", "listopadu",
+			"ноябрь", "ноября", "нояб", "ноя", "листопад", "листопада", "лист", "лис"},
+		{"dec", "dez", "dezember", "december", "desember", "décembre", "aralık", "ara",
+			"diciembre", "dic", "dicembre", "dezembro", "déc",
+			"grudzień", "grudnia", "grudzien", "gru", "prosinec", "prosince",
+			"декабрь", "декабря", "дек", "грудень", "грудня", "груд", "гру"},
 	}
 
 	mapNameNumber := make(map[string]int)
@@ -251,6 +322,9 @@ var (
 		"utime")
 	attrPublishClasses = sliceToMap("published", "date-published", "time-published")
 
+	jsonOriginalKeys = sliceToMap("datePublished", "dateCreated")
+	jsonModifiedKeys = sliceToMap("dateModified")
+
 	listItemPropAttrs = []string{"datecreated", "datepublished", "pubyear", "datemodified", "dateupdate"}
 	itemPropAttrKeys  = sliceToMap(listItemPropAttrs...)
 	itemPropOriginal  = sliceToMap(listItemPropAttrs[:3]...)
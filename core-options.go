@@ -18,6 +18,8 @@
 package htmldate
 
 import (
+	"context"
+	"log/slog"
 	"time"
 
 	dps "github.com/markusmobius/go-dateparser"
@@ -38,12 +40,23 @@ type Options struct {
 	// MinDate is the earliest acceptable date.
 	MinDate time.Time
 
-	// MaxDate is the latest acceptable date.
+	// MaxDate is the latest acceptable date. If not specified, it will be one year after `Now`.
 	MaxDate time.Time
 
-	// EnableLog specify whether log should be enabled or not.
+	// Now is the reference time of the extraction, used to compute the default `MaxDate` and
+	// to interpret partial or relative dates. It's useful for processing archived pages as if
+	// they were processed on their crawl date. If not specified, the current time at the
+	// start of each extraction will be used.
+	Now time.Time
+
+	// EnableLog specify whether log should be enabled or not. If `Logger` is not
+	// specified, the debug log will be printed to stderr.
 	EnableLog bool
 
+	// Logger is the logger that used for this extraction only. If nil and `EnableLog`
+	// is true, a new debug logger that prints to stderr will be created.
+	Logger *slog.Logger
+
 	// SkipExtensiveSearch specify whether to skip pattern-based opportunistic text search or not
 	// using the external `dateparser` library. Note: this extensive search might be quite slow,
 	// so use as necessary.
@@ -56,4 +69,71 @@ type Options struct {
 	// DateParserConfig is configuration for the external `dateparser`. Only used extensive search
 	// is enabled (`SkipExtensiveSearch=false`).
 	DateParserConfig *dps.Configuration
+
+	// Languages is the languages of the page as ISO 639-1 code, e.g. "en" or "de". It's used
+	// to select the weekday names for the fast parser, and to configure the languages of
+	// external `dateparser`. If not specified, it will be detected from `<html lang>`,
+	// `content-language` meta and `og:locale` of the page.
+	Languages []string
+
+	// RelativeDates specify whether to resolve relative date expressions (e.g. "3 hours ago",
+	// "gestern" or "il y a 2 jours") against `Now`. Only used for elements that likely contain
+	// date, and only when there are no absolute date found in those elements.
+	RelativeDates bool
+
+	// Pipeline is the stages for looking the date, sorted by its priority. The first stage
+	// that found a valid date wins. Use it to reorder, remove or insert custom stages. If
+	// nil, `DefaultPipeline` will be used.
+	Pipeline []Stage
+
+	// DateKeys is the keys that mark the original and modified date in hydration data of
+	// JavaScript frameworks and analytics snippets, e.g. "publishedAt" in `__NEXT_DATA__`
+	// or "publishDate" in `dataLayer.push({...})`. If nil, or if one of its list is nil,
+	// `DefaultDateKeys` will be used.
+	DateKeys *DateKeys
+
+	// Timeout is the time budget for a single extraction. Once exceeded, the extraction
+	// stops and returns the best date found so far along with `context.DeadlineExceeded`.
+	// Zero means no limit.
+	Timeout time.Duration
+
+	// Internal state for the extraction, filled by the extractor.
+	ctx            context.Context
+	log            logger
+	parser         *dps.Parser
+	relativeParser *dps.Parser
+	parserConfig   *dps.Configuration
+	languages      []string
+	dateOrder      dateOrder
+	candidates     *candidateSet
+	source         candidateSource
+	dateKeySet     *dateKeySet
+}
+
+// dateParser returns the external `dateparser` for absolute dates.
+func (opts Options) dateParser() *dps.Parser {
+	if opts.parser != nil {
+		return opts.parser
+	}
+	return externalParser
+}
+
+// relativeDateParser returns the external `dateparser` for relative dates.
+func (opts Options) relativeDateParser() *dps.Parser {
+	if opts.relativeParser != nil {
+		return opts.relativeParser
+	}
+	return relativeParser
+}
+
+// dateParserConfig returns the configuration for the external `dateparser`.
+func (opts Options) dateParserConfig() *dps.Configuration {
+	switch {
+	case opts.parserConfig != nil:
+		return opts.parserConfig
+	case opts.DateParserConfig != nil:
+		return opts.DateParserConfig
+	default:
+		return externalDpsConfig
+	}
 }
@@ -18,10 +18,11 @@
 package htmldate
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -31,49 +32,183 @@ import (
 	"github.com/go-shiori/dom"
 	"github.com/markusmobius/go-htmldate/internal/re2go"
 	"github.com/markusmobius/go-htmldate/internal/selector"
-	"github.com/rs/zerolog"
 	"golang.org/x/net/html"
 )
 
-var log zerolog.Logger
+// FromReader extract publish date from the specified reader.
+func FromReader(r io.Reader, opts Options) (Result, error) {
+	return FromReaderContext(context.Background(), r, opts)
+}
 
-func init() {
-	log = zerolog.New(zerolog.ConsoleWriter{
-		Out:        os.Stderr,
-		TimeFormat: "2006-01-02 15:04",
-	}).With().Timestamp().Logger().Level(zerolog.Disabled)
+// FromDocument extract publish date from the specified html document.
+func FromDocument(doc *html.Node, opts Options) (Result, error) {
+	return FromDocumentContext(context.Background(), doc, opts)
 }
 
-// FromReader extract publish date from the specified reader.
-func FromReader(r io.Reader, opts Options) (Result, error) {
+// FromReaderContext extract publish date from the specified reader. The extraction
+// stops when the context is canceled, see `FromDocumentContext` for details.
+func FromReaderContext(ctx context.Context, r io.Reader, opts Options) (Result, error) {
 	// Parse html document
 	doc, err := dom.Parse(r)
 	if err != nil {
 		return resultZero, err
 	}
 
-	return FromDocument(doc, opts)
+	return FromDocumentContext(ctx, doc, opts)
 }
 
-// FromDocument extract publish date from the specified html document.
-func FromDocument(doc *html.Node, opts Options) (Result, error) {
-	// Make sure document exist
-	if doc == nil {
-		return resultZero, fmt.Errorf("document is empty")
+// FromDocumentContext extract publish date from the specified html document. The
+// cancellation is checked between extraction stages and while looping through the
+// candidates. If the context is done (or `Options.Timeout` exceeded) before extraction
+// finished, it returns the best date found so far along with the context error.
+func FromDocumentContext(ctx context.Context, doc *html.Node, opts Options) (Result, error) {
+	e, err := NewExtractor(opts)
+	if err != nil {
+		return resultZero, err
 	}
 
-	// Clone document so the original kept untouched
-	doc = dom.Clone(doc, true)
+	return e.ExtractDocument(ctx, doc)
+}
+
+// FromReaderAll extract both the original publish date and the last modified date
+// from the specified reader.
+func FromReaderAll(r io.Reader, opts Options) (Dates, error) {
+	// Parse html document
+	doc, err := dom.Parse(r)
+	if err != nil {
+		return Dates{}, err
+	}
 
+	return FromDocumentAll(doc, opts)
+}
+
+// FromDocumentAll extract both the original publish date and the last modified date
+// from the specified html document. The document is only prepared once and shared by
+// both extractions, so it's cheaper than calling `FromDocument` twice. Option
+// `UseOriginalDate` is ignored here.
+func FromDocumentAll(doc *html.Node, opts Options) (Dates, error) {
+	e, err := NewExtractor(opts)
+	if err != nil {
+		return Dates{}, err
+	}
+
+	return e.ExtractAll(context.Background(), doc)
+}
+
+// FindCandidates runs all extraction methods to the specified html document and
+// returns every date candidates that found, sorted from the highest score.
+func FindCandidates(doc *html.Node, opts Options) ([]Candidate, error) {
+	e, err := NewExtractor(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.FindCandidates(context.Background(), doc)
+}
+
+// defaultMaxDate returns the latest acceptable date for the specified reference time.
+func defaultMaxDate(now time.Time) time.Time {
+	return now.AddDate(1, 0, 0)
+}
+
+// prepareOptions validates the options and applies its default values.
+func prepareOptions(opts Options) (Options, error) {
 	// Set default options
 	if opts.MinDate.IsZero() {
 		opts.MinDate = defaultMinDate
 	}
 
+	if opts.MaxDate.IsZero() && !opts.Now.IsZero() {
+		opts.MaxDate = defaultMaxDate(opts.Now)
+	}
+
+	// Validate options
+	maxDate := opts.MaxDate
+	if maxDate.IsZero() {
+		maxDate = defaultMaxDate(time.Now())
+	}
+
+	if opts.MinDate.After(maxDate) {
+		return opts, fmt.Errorf("min date %s is after max date %s",
+			opts.MinDate.Format(time.RFC3339), maxDate.Format(time.RFC3339))
+	}
+
+	if opts.Timeout < 0 {
+		return opts, fmt.Errorf("timeout must not be negative: %v", opts.Timeout)
+	}
+
+	// Prepare pipeline
+	if opts.Pipeline != nil {
+		pipeline := make([]Stage, len(opts.Pipeline))
+		for i, stage := range opts.Pipeline {
+			if stage == nil {
+				return opts, fmt.Errorf("stage %d in pipeline is nil", i)
+			}
+			pipeline[i] = stage
+		}
+		opts.Pipeline = pipeline
+	}
+
+	// Normalize languages
+	var languages []string
+	for _, tag := range opts.Languages {
+		lang := normalizeLanguage(tag)
+		if lang == "" {
+			return opts, fmt.Errorf("invalid language: %q", tag)
+		}
+		if !slices.Contains(languages, lang) {
+			languages = append(languages, lang)
+		}
+	}
+	opts.languages = languages
+
+	// Prepare date parser
+	if opts.DateParserConfig != nil {
+		opts.parserConfig = opts.DateParserConfig.Clone()
+	} else {
+		opts.parserConfig = externalDpsConfig.Clone()
+	}
+
+	// Prepare key vocabulary for script data
+	opts.dateKeySet = newDateKeySet(opts.DateKeys)
+
+	// Prepare logger
+	opts.log = newLogger(opts)
+
+	return opts, nil
+}
+
+// prepareDocument clones the document, resolves the reference time and looks for its
+// URL if necessary.
+func prepareDocument(doc *html.Node, opts Options) (*html.Node, Options, error) {
+	// Make sure document exist
+	if doc == nil {
+		return nil, opts, fmt.Errorf("document is empty")
+	}
+
+	// Resolve the reference time
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
 	if opts.MaxDate.IsZero() {
-		opts.MaxDate = defaultMaxDate
+		opts.MaxDate = defaultMaxDate(opts.Now)
 	}
 
+	if opts.parserConfig != nil && opts.parserConfig.CurrentTime.IsZero() {
+		opts.parserConfig = opts.parserConfig.Clone()
+		opts.parserConfig.CurrentTime = opts.Now
+	}
+
+	// If languages is not defined in options, detect it from the page
+	if len(opts.languages) == 0 {
+		opts.languages = detectLanguages(doc)
+	}
+	opts = opts.applyLanguages()
+
+	// Clone document so the original kept untouched
+	doc = dom.Clone(doc, true)
+
 	// If URL is not defined in options, look in elements
 	if opts.URL == "" {
 		links := dom.QuerySelectorAll(doc, `link[rel="canonical"]`)
@@ -93,23 +228,50 @@ func FromDocument(doc *html.Node, opts Options) (Result, error) {
 		}
 	}
 
-	// Prepare logger
-	if opts.EnableLog {
-		log = log.Level(zerolog.DebugLevel)
-	}
+	// Decide the order of day and month for ambiguous numeric dates
+	opts.dateOrder = detectDateOrder(doc, opts)
+	opts = opts.applyDateOrder()
 
-	// Extract date
-	rawString, date, err := findDate(doc, opts)
-	if err != nil {
-		return resultZero, err
-	}
+	return doc, opts, nil
+}
+
+// withContext returns copy of options that used for extraction within the specified
+// context, which also passed to the logger.
+func (opts Options) withContext(ctx context.Context) Options {
+	opts.ctx = ctx
+	opts.log.ctx = ctx
+	return opts
+}
 
+// canceled reports whether the extraction has been canceled or its deadline exceeded.
+func (opts Options) canceled() bool {
+	return opts.ctx != nil && opts.ctx.Err() != nil
+}
+
+// createResult creates the extraction result for the date and its raw string.
+func createResult(rawString string, date time.Time, opts Options) Result {
 	// Extract time if required
 	var timeFound bool
 	var timezoneFound bool
 
-	if opts.ExtractTime {
-		h, m, s, tz, found := findTime(rawString)
+	// ISO 8601 string already contains date, time and offset together
+	isoDateTime, isoTime, isoOffset, isISO := parseISO8601(rawString)
+	isISO = isISO && sameDate(isoDateTime, date)
+
+	// Epoch timestamp is always in UTC
+	epochDateTime, isEpoch := parseEpoch(rawString)
+	isEpoch = isEpoch && sameDate(epochDateTime, date)
+
+	if opts.ExtractTime && isISO {
+		timeFound, timezoneFound = isoTime, isoOffset
+		if isoTime {
+			date = isoDateTime
+		}
+	} else if opts.ExtractTime && isEpoch {
+		timeFound, timezoneFound = true, true
+		date = epochDateTime
+	} else if opts.ExtractTime {
+		h, m, s, tz, found := findTime(rawString, opts)
 		if found {
 			timeFound = true
 			date = date.Add(time.Hour * time.Duration(h))
@@ -129,150 +291,85 @@ func FromDocument(doc *html.Node, opts Options) (Result, error) {
 		HasTime:     timeFound,
 		HasTimezone: timezoneFound,
 		SrcString:   normalizeSpaces(rawString),
-	}, nil
+
+		AmbiguousDateOrder: opts.dateOrder == dateOrderUnknown && isAmbiguousDate(rawString, date) &&
+			!weekdayDecidesOrder(rawString, date, opts),
+	}
 }
 
 // findDate extract publish date from the specified html document.
 func findDate(doc *html.Node, opts Options) (string, time.Time, error) {
-	// If not deferred, check URL first
-	var urlDate time.Time
-	if opts.URL != "" {
-		urlDate = extractUrlDate(opts.URL, opts)
-		if !urlDate.IsZero() && !opts.DeferUrlExtractor {
-			return opts.URL, urlDate, nil
-		}
-	}
-
-	// Try from head elements
-	rawString, metaResult := examineMetaElements(doc, opts)
-	if !metaResult.IsZero() {
-		return rawString, metaResult, nil
-	}
-
-	// Try to use JSON data
-	rawString, jsonResult := jsonSearch(doc, opts)
-	if !jsonResult.IsZero() {
-		return rawString, jsonResult, nil
-	}
-
-	// If deferred, process URL here (may be moved even further down if necessary)
-	if opts.DeferUrlExtractor && !urlDate.IsZero() {
-		return opts.URL, urlDate, nil
-	}
-
-	// Try <abbr> elements
-	rawString, abbrResult := examineAbbrElements(doc, opts)
-	if !abbrResult.IsZero() {
-		return rawString, abbrResult, nil
-	}
-
-	// First, prune tree
-	prunedDoc := dom.Clone(doc, true)
-	prunedDoc = cleanDocument(prunedDoc)
-	discardUnwanted(prunedDoc)
-
-	// Define selectors + text content
-	var dateSelector selector.Rule
-	if !opts.SkipExtensiveSearch {
-		dateSelector = selector.SlowDate
-	} else {
-		dateSelector = selector.FastDate
-	}
+	rawString, date, _ := runDateStages(&Page{doc: doc}, opts)
+	return rawString, date, nil
+}
 
-	// Then look for expressions
-	dateElements := selector.QueryAll(prunedDoc, dateSelector)
-	rawString, dateResult := examineOtherElements(dateElements, opts)
-	if !dateResult.IsZero() {
-		return rawString, dateResult, nil
+// detectDate extract publish date from the page, along with the method and element
+// where it found.
+func detectDate(p *Page, opts Options) Result {
+	rawString, date, method := runDateStages(p, opts)
+	if date.IsZero() {
+		return resultZero
 	}
 
-	// Try title elements
-	titleElements := dom.QuerySelectorAll(prunedDoc, "title, h1")
-	rawString, dateResult = examineOtherElements(titleElements, opts)
-	if !dateResult.IsZero() {
-		return rawString, dateResult, nil
-	}
-
-	// Try <time> elements
-	rawString, timeResult := examineTimeElements(prunedDoc, opts)
-	if !timeResult.IsZero() {
-		return rawString, timeResult, nil
-	}
-
-	// TODO: for now, we'll stop searching in discarded elements
-	// Search in the discarded elements (currently: footers and archive.org banner)
-	// for _, subTree := range discarded {
-	// 	dateElements := htmlxpath.Find(subTree, dateXpathQuery)
-	// 	rawString, dateResult := examineOtherElements(dateElements, opts)
-	// 	if !dateResult.IsZero() {
-	// 		return rawString, dateResult, nil
-	// 	}
-	// }
-
-	// Conversion to string
-	var htmlString string
-	htmlNode := dom.QuerySelector(prunedDoc, "html")
-	if htmlNode != nil {
-		htmlString = dom.InnerHTML(htmlNode)
-	} else {
-		htmlString = dom.InnerHTML(prunedDoc)
+	result := createResult(rawString, date, opts)
+	result.Method = method
+	if src, found := opts.candidates.find(method, date); found {
+		result.Attribute = src.attribute
+		result.ElementPath = elementPath(src.node)
+		result.Relative = src.relative
+		result.EntityType = src.entityType
+		result.EntityID = src.entityID
+		result.Calendar = src.calendar
 	}
 
-	// String search using regex timestamp
-	rawString, timestampResult := regexPatternSearch(
-		htmlString, "Timestamp",
-		re2go.TimestampPatternSubmatch, opts)
-	if !timestampResult.IsZero() {
-		return rawString, timestampResult, nil
-	}
+	return result
+}
 
-	// Try URL from image metadata
-	rawString, imgResult := metaImgSearch(prunedDoc, opts)
-	if !imgResult.IsZero() {
-		return rawString, imgResult, nil
-	}
+// runDateStages runs the extraction stages until one of them found a date.
+func runDateStages(p *Page, opts Options) (string, time.Time, Method) {
+	for _, stage := range opts.pipeline() {
+		if opts.canceled() {
+			opts.log.Debugf("extraction canceled before %s stage", stage.Method())
+			break
+		}
 
-	// Precise patterns and idiosyncrasies
-	rawString, textResult := idiosyncrasiesSearch(htmlString, opts)
-	if !textResult.IsZero() {
-		return rawString, textResult, nil
+		rawString, date := stage.Find(p, opts)
+		if !date.IsZero() {
+			return rawString, date, stage.Method()
+		}
 	}
 
-	// Last resort: do extensive search.
-	if !opts.SkipExtensiveSearch {
-		log.Debug().Msg("extensive search started")
+	return "", timeZero, MethodUnknown
+}
 
-		// TODO: further tests & decide according to original_date
-		var refValue int64
-		var refString string
-		for _, segment := range selector.QueryAllTextNodes(prunedDoc, selector.FreeText) {
-			// Basic filter: minimum could be 8 or 9
-			text := normalizeSpaces(segment.Data)
-			nText := utf8.RuneCountInString(text)
-			if nText > minSegmentLen && nText < maxSegmentLen {
-				refString, refValue = compareReference(refString, refValue, text, opts)
-			}
-		}
+// extensiveSearch looks for the date in free text of the document.
+func extensiveSearch(doc *html.Node, opts Options) (string, time.Time) {
+	opts.log.Debugf("extensive search started")
 
-		// Return
-		converted := checkExtractedReference(refValue, opts)
-		if !converted.IsZero() {
-			return refString, converted, nil
+	// TODO: further tests & decide according to original_date
+	var refValue int64
+	var refString string
+	for _, segment := range selector.QueryAllTextNodes(doc, selector.FreeText) {
+		if opts.canceled() {
+			break
 		}
 
-		// Search page HTML
-		rawString, searchResult := searchPage(htmlString, opts)
-		if !searchResult.IsZero() {
-			return rawString, searchResult, nil
+		// Basic filter: minimum could be 8 or 9
+		text := normalizeSpaces(segment.Data)
+		nText := utf8.RuneCountInString(text)
+		if nText > minSegmentLen && nText < maxSegmentLen {
+			segmentOpts := opts.from(MethodFreeText, segment.Parent, "")
+			refString, refValue = compareReference(refString, refValue, text, segmentOpts)
 		}
 	}
 
-	return "", timeZero, nil
+	// Return
+	return refString, checkExtractedReference(refValue, opts)
 }
 
-func findTime(rawString string) (hour, minute, second int, timezone *time.Location, timeFound bool) {
+func findTime(rawString string, opts Options) (hour, minute, second int, timezone *time.Location, timeFound bool) {
 	// If raw string is empty, return early
-	rawString = normalizeSpaces(rawString)
+	rawString = normalizeSpaces(normalizeDigits(rawString))
 	if rawString == "" {
 		return
 	}
@@ -281,7 +378,7 @@ func findTime(rawString string) (hour, minute, second int, timezone *time.Locati
 	// While looking for ISO-8601, remove the matches so the later regex not confused.
 	rawString = rxIsoTime.ReplaceAllStringFunc(rawString, func(match string) string {
 		if !timeFound {
-			log.Debug().Msgf("found ISO-8601 time: %s", rawString)
+			opts.log.Debugf("found ISO-8601 time: %s", rawString)
 
 			parts := rxIsoTime.FindStringSubmatch(match)
 			hour, _ = strconv.Atoi(parts[1])
@@ -341,7 +438,29 @@ func findTime(rawString string) (hour, minute, second int, timezone *time.Locati
 				hour += 12
 			}
 
-			log.Debug().Msgf("found common format time: %s", rawString)
+			opts.log.Debugf("found common format time: %s", rawString)
+			timeFound = true
+		}
+	}
+
+	// Try time with CJK suffixes, e.g. 19時25分, 下午7时25分 or 오후 7시 25분
+	if !timeFound {
+		parts := rxCjkTime.FindStringSubmatch(rawString)
+
+		if len(parts) > 0 {
+			hour, _ = strconv.Atoi(parts[2])
+			minute, _ = strconv.Atoi(parts[3])
+			second, _ = strconv.Atoi(parts[4])
+
+			// Convert 12-hour clock to 24-hour
+			switch parts[1] {
+			case "午後", "下午", "오후":
+				if hour < 12 {
+					hour += 12
+				}
+			}
+
+			opts.log.Debugf("found CJK format time: %s", rawString)
 			timeFound = true
 		}
 	}
@@ -351,8 +470,8 @@ func findTime(rawString string) (hour, minute, second int, timezone *time.Locati
 
 // examineMetaElements parse meta elements to find date cues.
 func examineMetaElements(doc *html.Node, opts Options) (string, time.Time) {
-	var tMeta, tReserve time.Time
-	var strMeta, strReserve string
+	var tMeta, tReserve, tFirst time.Time
+	var strMeta, strReserve, strFirst string
 
 	// Loop through all meta elements
 	for _, elem := range dom.QuerySelectorAll(doc, "meta") {
@@ -377,18 +496,20 @@ func examineMetaElements(doc *html.Node, opts Options) (string, time.Time) {
 
 		if name != "" && content != "" { // Name attribute first: the most frequent
 			name = strings.ToLower(name)
+			metaOpts := opts.from(MethodMeta, elem, name)
 			if name == "og:url" { // url
 				strReserve = content
 				tReserve = extractUrlDate(content, opts)
+				metaOpts.asReserve().record(strReserve, tReserve)
 			} else if inMap(name, dateAttributes) { // date
-				log.Debug().Msgf("examining meta name: %s", outerHtml)
-				strMeta, tMeta = tryDateExpr(content, opts)
+				opts.log.Debugf("examining meta name: %s", outerHtml)
+				strMeta, tMeta = tryStructuredDate(content, metaOpts)
 			} else if inMap(name, attrModifiedNames) { // modified
-				log.Debug().Msgf("examining meta name: %s", outerHtml)
+				opts.log.Debugf("examining meta name: %s", outerHtml)
 				if !opts.UseOriginalDate {
-					strMeta, tMeta = tryDateExpr(content, opts)
+					strMeta, tMeta = tryStructuredDate(content, metaOpts)
 				} else {
-					strReserve, tReserve = tryDateExpr(content, opts)
+					strReserve, tReserve = tryStructuredDate(content, metaOpts.asReserve())
 				}
 			}
 		} else if property != "" && content != "" { // Property attribute
@@ -397,80 +518,85 @@ func examineMetaElements(doc *html.Node, opts Options) (string, time.Time) {
 			inDateAttributes := inMap(attribute, dateAttributes)
 
 			if inDateAttributes || inModifiedProps {
-				log.Debug().Msgf("examining meta property: %s", outerHtml)
-				strAttempt, tAttempt := tryDateExpr(content, opts)
-				if !tAttempt.IsZero() {
-					if (inDateAttributes && opts.UseOriginalDate) ||
-						(inModifiedProps && !opts.UseOriginalDate) {
-						strMeta, tMeta = strAttempt, tAttempt
-					} else {
-						// Hurts precision
-						strReserve, tReserve = strAttempt, tAttempt
-					}
+				opts.log.Debugf("examining meta property: %s", outerHtml)
+				metaOpts := opts.from(MethodMeta, elem, attribute)
+				if (inDateAttributes && opts.UseOriginalDate) ||
+					(inModifiedProps && !opts.UseOriginalDate) {
+					strMeta, tMeta = tryStructuredDate(content, metaOpts)
+				} else if strAttempt, tAttempt := tryStructuredDate(content, metaOpts.asReserve()); !tAttempt.IsZero() {
+					// Hurts precision
+					strReserve, tReserve = strAttempt, tAttempt
 				}
 			}
 		} else if itemProp != "" { // Item scope
 			attribute := strings.ToLower(itemProp)
+			metaOpts := opts.from(MethodMeta, elem, attribute)
 			if inMap(attribute, itemPropAttrKeys) {
-				var strAttempt string
-				var tAttempt time.Time
-				log.Debug().Msgf("examining meta itemprop: %s", outerHtml)
-
-				if dateTime != "" {
-					strAttempt, tAttempt = tryDateExpr(dateTime, opts)
-				} else if content != "" {
-					strAttempt, tAttempt = tryDateExpr(content, opts)
-				}
-
-				if !tAttempt.IsZero() {
-					if (inMap(attribute, itemPropOriginal) && opts.UseOriginalDate) ||
-						(inMap(attribute, itemPropModified) && !opts.UseOriginalDate) {
-						strMeta, tMeta = strAttempt, tAttempt
-						// } else {
-						// TODO: put on hold, hurts precision
-						// strReserve, tReserve = strAttempt, tAttempt
+				opts.log.Debugf("examining meta itemprop: %s", outerHtml)
+				if (inMap(attribute, itemPropOriginal) && opts.UseOriginalDate) ||
+					(inMap(attribute, itemPropModified) && !opts.UseOriginalDate) {
+					if dateTime != "" {
+						strMeta, tMeta = tryStructuredDate(dateTime, metaOpts)
+					} else if content != "" {
+						strMeta, tMeta = tryStructuredDate(content, metaOpts)
 					}
+					// } else {
+					// TODO: put on hold, hurts precision
+					// strReserve, tReserve = tryStructuredDate(content, metaOpts.asReserve())
 				}
 			} else if attribute == "copyrightyear" { // reserve with copyrightyear
-				log.Debug().Msgf("examining meta itemprop: %s", outerHtml)
+				opts.log.Debugf("examining meta itemprop: %s", outerHtml)
 				if content != "" {
 					attempt := content + "-01-01"
 					tAttempt, err := time.Parse("2006-01-02", attempt)
 					if err == nil && validateDate(tAttempt, opts) {
 						strReserve, tReserve = content, tAttempt
+						metaOpts.asReserve().record(strReserve, tReserve)
 					}
 				}
 			}
 		} else if strings.ToLower(pubDate) == "pubdate" { // Publish date, relatively rare
-			log.Debug().Msgf("examining meta pubdate: %s", outerHtml)
-			strMeta, tMeta = tryDateExpr(content, opts)
+			opts.log.Debugf("examining meta pubdate: %s", outerHtml)
+			strMeta, tMeta = tryStructuredDate(content, opts.from(MethodMeta, elem, "pubdate"))
 		} else if httpEquiv != "" && content != "" { // http-equiv, rare http://www.standardista.com/html5/http-equiv-the-meta-attribute-explained/
 			attribute := strings.ToLower(httpEquiv)
+			metaOpts := opts.from(MethodMeta, elem, attribute)
 			if attribute == "date" {
-				log.Debug().Msgf("examining meta httpequiv: %s", outerHtml)
+				opts.log.Debugf("examining meta httpequiv: %s", outerHtml)
 				if opts.UseOriginalDate {
-					strMeta, tMeta = tryDateExpr(content, opts)
+					strMeta, tMeta = tryStructuredDate(content, metaOpts)
 				} else {
-					strReserve, tReserve = tryDateExpr(content, opts)
+					strReserve, tReserve = tryStructuredDate(content, metaOpts.asReserve())
 				}
 			} else if attribute == "last-modified" {
-				log.Debug().Msgf("examining meta httpequiv: %s", outerHtml)
+				opts.log.Debugf("examining meta httpequiv: %s", outerHtml)
 				if !opts.UseOriginalDate {
-					strMeta, tMeta = tryDateExpr(content, opts)
+					strMeta, tMeta = tryStructuredDate(content, metaOpts)
 				} else {
-					strReserve, tReserve = tryDateExpr(content, opts)
+					strReserve, tReserve = tryStructuredDate(content, metaOpts.asReserve())
 				}
 			}
 		}
 
-		// Exit loop
+		// Exit loop, unless all candidates are being collected
 		if !tMeta.IsZero() {
-			return strMeta, tMeta
+			if !opts.exhaustive() {
+				return strMeta, tMeta
+			}
+
+			if tFirst.IsZero() {
+				strFirst, tFirst = strMeta, tMeta
+			}
+			strMeta, tMeta = "", timeZero
 		}
 	}
 
+	if !tFirst.IsZero() {
+		return strFirst, tFirst
+	}
+
 	// If nothing was found, look for lower granularity (so far: "copyright year")
-	log.Debug().Msg("opting for reserve date with less granularity")
+	opts.log.Debugf("opting for reserve date with less granularity")
 	return strReserve, tReserve
 }
 
@@ -487,16 +613,27 @@ func examineAbbrElements(doc *html.Node, opts Options) (string, time.Time) {
 	var refValue int64
 	var refString string
 	for _, elem := range elements {
+		if opts.canceled() {
+			break
+		}
+
 		class := strings.TrimSpace(dom.GetAttribute(elem, "class"))
 		dataUtime := strings.TrimSpace(dom.GetAttribute(elem, "data-utime"))
 
 		// Handle data-utime (mostly Facebook)
 		if dataUtime != "" {
-			candidate, err := strconv.ParseInt(dataUtime, 10, 64)
+			candidate, err := strconv.ParseInt(normalizeDigits(dataUtime), 10, 64)
 			if err != nil {
 				continue
 			}
-			log.Debug().Msgf("data-utime found: %d", candidate)
+
+			if epoch, isEpoch := parseEpoch(dataUtime); isEpoch {
+				candidate = epoch.Unix()
+			}
+			opts.log.Debugf("data-utime found: %d", candidate)
+			if dt := checkExtractedReference(candidate, opts); !dt.IsZero() {
+				opts.from(MethodAbbr, elem, "data-utime").record(dataUtime, dt)
+			}
 
 			if opts.UseOriginalDate { // Look for original date
 				if refValue == 0 || candidate < refValue {
@@ -516,23 +653,25 @@ func examineAbbrElements(doc *html.Node, opts Options) (string, time.Time) {
 			// Other attributes
 			if title != "" {
 				tryText := title
-				log.Debug().Msgf("abbr published-title found: %s", tryText)
+				opts.log.Debugf("abbr published-title found: %s", tryText)
 
+				titleOpts := opts.from(MethodAbbr, elem, "title")
 				if opts.UseOriginalDate {
-					_, attempt := tryDateExpr(tryText, opts)
+					_, attempt := tryDateExpr(tryText, titleOpts)
 					if !attempt.IsZero() {
 						return tryText, attempt
 					}
 				} else {
-					refString, refValue = compareReference(refString, refValue, tryText, opts)
+					refString, refValue = compareReference(refString, refValue, tryText, titleOpts)
 					if refValue > 0 {
 						break
 					}
 				}
 			} else if utf8.RuneCountInString(text) > 10 { // Dates, not times of the day
 				tryText := strings.TrimPrefix(text, "am ")
-				log.Debug().Msgf("abbr published found: %s", tryText)
-				refString, refValue = compareReference(refString, refValue, tryText, opts)
+				opts.log.Debugf("abbr published found: %s", tryText)
+				textOpts := opts.from(MethodAbbr, elem, "")
+				refString, refValue = compareReference(refString, refValue, tryText, textOpts)
 			}
 		}
 	}
@@ -545,7 +684,7 @@ func examineAbbrElements(doc *html.Node, opts Options) (string, time.Time) {
 
 	// Try rescue in abbr content
 	abbrElements := dom.GetElementsByTagName(doc, "abbr")
-	rawString, dateResult := examineOtherElements(abbrElements, opts)
+	rawString, dateResult := examineOtherElements(abbrElements, MethodAbbr, opts)
 	if !dateResult.IsZero() {
 		return rawString, dateResult
 	}
@@ -567,6 +706,10 @@ func examineTimeElements(doc *html.Node, opts Options) (string, time.Time) {
 	var refValue int64
 	var refString string
 	for _, elem := range elements {
+		if opts.canceled() {
+			break
+		}
+
 		var shortcutFlag bool
 		text := normalizeSpaces(etreeText(elem))
 		class := strings.TrimSpace(dom.GetAttribute(elem, "class"))
@@ -576,34 +719,36 @@ func examineTimeElements(doc *html.Node, opts Options) (string, time.Time) {
 		if utf8.RuneCountInString(dateTime) > 6 { // Go for datetime attribute
 			if strings.ToLower(pubDate) == "pubdate" && opts.UseOriginalDate { // Shortcut: time pubdate
 				shortcutFlag = true
-				log.Debug().Msgf("shortcut for time pubdate found: %s", dateTime)
+				opts.log.Debugf("shortcut for time pubdate found: %s", dateTime)
 			} else if class != "" { // Shortcut: class attribute
 				classIsDateTime := strings.HasPrefix(class, "entry-date")
 				classIsDateTime = classIsDateTime || strings.HasPrefix(class, "entry-time")
 
 				if opts.UseOriginalDate && classIsDateTime {
 					shortcutFlag = true
-					log.Debug().Msgf("shortcut for time/datetime found: %s", dateTime)
+					opts.log.Debugf("shortcut for time/datetime found: %s", dateTime)
 				} else if !opts.UseOriginalDate && class == "updated" {
 					shortcutFlag = true
-					log.Debug().Msgf("shortcut for updated time/datetime found: %s", dateTime)
+					opts.log.Debugf("shortcut for updated time/datetime found: %s", dateTime)
 				}
 			} else { // Datetime attribute
-				log.Debug().Msgf("time/datetime found: %s", dateTime)
+				opts.log.Debugf("time/datetime found: %s", dateTime)
 			}
 
 			// Analyze attribute
+			attrOpts := opts.from(MethodTime, elem, "datetime")
 			if shortcutFlag {
-				_, attempt := tryDateExpr(dateTime, opts)
+				_, attempt := tryDateExpr(dateTime, attrOpts)
 				if !attempt.IsZero() {
 					return dateTime, attempt
 				}
 			} else {
-				refString, refValue = compareReference(refString, refValue, dateTime, opts)
+				refString, refValue = compareReference(refString, refValue, dateTime, attrOpts)
 			}
 		} else if utf8.RuneCountInString(text) > 6 { // Bare text in element
-			log.Debug().Msgf("time/datetime found in text: %s", text)
-			refString, refValue = compareReference(refString, refValue, text, opts)
+			opts.log.Debugf("time/datetime found in text: %s", text)
+			textOpts := opts.from(MethodTime, elem, "")
+			refString, refValue = compareReference(refString, refValue, text, textOpts)
 		}
 	}
 
@@ -630,32 +775,50 @@ func examineText(text string, opts Options) (string, time.Time) {
 
 // examineOtherElements scans the specified elements and check if their content
 // contains an eligible date.
-func examineOtherElements(elements []*html.Node, opts Options) (string, time.Time) {
+func examineOtherElements(elements []*html.Node, method Method, opts Options) (string, time.Time) {
 	// Make sure elements exist and less than `maxPossibleCandidates`
 	if nElements := len(elements); nElements == 0 || nElements >= maxPossibleCandidates {
 		return "", timeZero
 	}
 
+	var relString string
+	var relDate time.Time
+	tryRelative := opts.RelativeDates && method == MethodSelector
+
 	for _, elem := range elements {
+		if opts.canceled() {
+			break
+		}
+
 		// Trim text content
 		text := dom.TextContent(elem)
 		titleAttr := dom.GetAttribute(elem, "title")
 
-		for _, text := range []string{text, titleAttr} {
-			_, attempt := examineText(text, opts)
+		sources := []struct{ text, attr string }{{text, ""}, {titleAttr, "title"}}
+		for _, src := range sources {
+			srcOpts := opts.from(method, elem, src.attr)
+			_, attempt := examineText(src.text, srcOpts)
 			if !attempt.IsZero() {
-				return text, attempt
+				return src.text, attempt
+			}
+
+			// Relative date is only used when there are no absolute date
+			if tryRelative && relDate.IsZero() {
+				relString, relDate = tryRelativeDateExpr(src.text, srcOpts.asRelative())
 			}
 		}
 	}
 
-	return "", timeZero
+	if relDate.IsZero() {
+		return "", timeZero
+	}
+	return relString, relDate
 }
 
 // searchPage opportunistically search the HTML text for common text patterns.
 func searchPage(htmlString string, opts Options) (string, time.Time) {
 	// Copyright symbol
-	log.Debug().Msg("looking for copyright/footer information")
+	opts.log.Debugf("looking for copyright/footer information")
 
 	var copYear int
 	var copRawString string
@@ -663,17 +826,31 @@ func searchPage(htmlString string, opts Options) (string, time.Time) {
 	if len(bestMatch) > 0 {
 		year, _ := strconv.Atoi(bestMatch[0])
 		if _, valid := validateDateParts(year, 1, 1, opts); valid {
-			log.Debug().Msgf("copyright year/footer pattern found: %d", year)
+			opts.log.Debugf("copyright year/footer pattern found: %d", year)
 			copRawString = rawString
 			copYear = year
 		}
 	}
 
+	// Copyright year is used as default when nothing else found, or when the
+	// extraction is canceled before the other patterns checked
+	fallback := func() (string, time.Time) {
+		if copYear != 0 {
+			opts.log.Debugf("using copyright year as default")
+			return copRawString, time.Date(copYear, 1, 1, 0, 0, 0, 0, time.UTC)
+		}
+		return "", timeZero
+	}
+
 	// 3 components
-	log.Debug().Msg("3 components")
+	opts.log.Debugf("3 components")
 
 	// Target URL characteristics, then more loosely structured date
 	for _, rx := range rxThreeComponents {
+		if opts.canceled() {
+			return fallback()
+		}
+
 		rawString, bestMatch = searchPattern(htmlString, rx.Pattern, rx.Catcher, rxYearPattern, opts)
 		result := filterYmdCandidate(bestMatch, rx.Name, copYear, opts)
 		if !result.IsZero() {
@@ -681,17 +858,51 @@ func searchPage(htmlString string, opts Options) (string, time.Time) {
 		}
 	}
 
+	// CJK dates, with full-width digits normalized first
+	if opts.canceled() {
+		return fallback()
+	}
+
+	rawString, bestMatch = searchPattern(normalizeDigits(htmlString),
+		findCjkDates, rxCjkCatch, rxYearPattern, opts)
+	result := filterYmdCandidate(bestMatch, "CjkPattern", copYear, opts)
+	if !result.IsZero() {
+		return rawString, result
+	}
+
+	// Roman numeral months, normalize candidates first
+	if opts.canceled() {
+		return fallback()
+	}
+
+	candidates := plausibleYearFilter(htmlString, findRomanDates, rxRomanYear, false, opts)
+	candidates = normalizeCandidates(candidates, opts)
+
+	rawString, bestMatch = selectCandidate(candidates, rxYmdPattern, rxYmdYear, opts)
+	result = filterYmdCandidate(bestMatch, "RomanPattern", copYear, opts)
+	if !result.IsZero() {
+		return rawString, result
+	}
+
 	// Handle YYYY-MM-DD/DD-MM-YYYY, normalize candidates first
-	candidates := plausibleYearFilter(htmlString, re2go.SelectYmdPattern, rxSelectYmdYear, false, opts)
+	if opts.canceled() {
+		return fallback()
+	}
+
+	candidates = plausibleYearFilter(htmlString, re2go.SelectYmdPattern, rxSelectYmdYear, false, opts)
 	candidates = normalizeCandidates(candidates, opts)
 
 	rawString, bestMatch = selectCandidate(candidates, rxYmdPattern, rxYmdYear, opts)
-	result := filterYmdCandidate(bestMatch, "SelectYmdPattern", copYear, opts)
+	result = filterYmdCandidate(bestMatch, "SelectYmdPattern", copYear, opts)
 	if !result.IsZero() {
 		return rawString, result
 	}
 
 	// Valid dates string
+	if opts.canceled() {
+		return fallback()
+	}
+
 	rawString, bestMatch = searchPattern(htmlString, re2go.DateStringsPattern, rxDateStringsCatch, rxYearPattern, opts)
 	result = filterYmdCandidate(bestMatch, "DateStringsPattern", copYear, opts)
 	if !result.IsZero() {
@@ -699,6 +910,10 @@ func searchPage(htmlString string, opts Options) (string, time.Time) {
 	}
 
 	// Handle DD?/MM?/YYYY, normalize candidates first
+	if opts.canceled() {
+		return fallback()
+	}
+
 	candidates = plausibleYearFilter(htmlString, re2go.SlashesPattern, rxSlashesYear, true, opts)
 	candidates = normalizeCandidates(candidates, opts)
 
@@ -709,7 +924,11 @@ func searchPage(htmlString string, opts Options) (string, time.Time) {
 	}
 
 	// 2 components
-	log.Debug().Msg("switching to 2 components")
+	if opts.canceled() {
+		return fallback()
+	}
+
+	opts.log.Debugf("switching to 2 components")
 
 	// First option
 	rawString, bestMatch = searchPattern(htmlString, re2go.YyyyMmPattern, rxYyyyMmCatch, rxYearPattern, opts)
@@ -717,7 +936,7 @@ func searchPage(htmlString string, opts Options) (string, time.Time) {
 		str := fmt.Sprintf("%s-%s-1", bestMatch[1], bestMatch[2])
 		dt, err := time.Parse("2006-1-2", str)
 		if err == nil && validateDate(dt, opts) && (copYear == 0 || dt.Year() >= copYear) {
-			log.Debug().Msgf("date found for pattern \"%s\": %s", "YyyyMmPattern", str)
+			opts.log.Debugf("date found for pattern \"%s\": %s", "YyyyMmPattern", str)
 			return rawString, dt
 		}
 	}
@@ -765,20 +984,23 @@ func searchPage(htmlString string, opts Options) (string, time.Time) {
 
 	// Try full-blown text regex on all HTML?
 	// TODO: find all candidates and disambiguate?
+	if opts.canceled() {
+		return fallback()
+	}
+
 	dt := regexParse(htmlString, opts)
 	if validateDate(dt, opts) && (copYear == 0 || dt.Year() >= copYear) {
-		log.Debug().Msg("regex result on HTML: " + dt.String())
+		opts.log.Debugf("regex result on HTML: %s", dt)
 		return htmlString, dt
 	}
 
 	// Catch all: copyright mention
 	if copYear != 0 {
-		log.Debug().Msg("using copyright year as default")
-		return copRawString, time.Date(copYear, 1, 1, 0, 0, 0, 0, time.UTC)
+		return fallback()
 	}
 
 	// Last resort: 1 component
-	log.Debug().Msg("switching to one component")
+	opts.log.Debugf("switching to one component")
 
 	// Clean string from W3 URLs.
 	// This is done because unlike Python, Go doesn't support negative look behind.
@@ -789,7 +1011,7 @@ func searchPage(htmlString string, opts Options) (string, time.Time) {
 		str := fmt.Sprintf("%s-1-1", bestMatch[1])
 		dt, err := time.Parse("2006-1-2", str)
 		if err == nil && validateDate(dt, opts) && dt.Year() >= copYear {
-			log.Debug().Msgf("date found for pattern \"%s\": %s", "SimplePattern", str)
+			opts.log.Debugf("date found for pattern \"%s\": %s", "SimplePattern", str)
 			return rawString, dt
 		}
 	}
@@ -813,6 +1035,22 @@ func compareReference(refString string, refValue int64, expression string, opts
 	return refString, refValue
 }
 
+// findCjkDates returns the position of every CJK date in the HTML string.
+func findCjkDates(htmlString string) [][]int {
+	return rxCjkCatch.FindAllStringIndex(htmlString, -1)
+}
+
+// findRomanDates returns the location of dates with Roman numeral month, where the first
+// submatch is the date itself without its boundaries.
+func findRomanDates(htmlString string) [][]int {
+	if !strings.ContainsAny(htmlString, "IVX") {
+		return nil
+	}
+
+	idxs := rxRomanDMY.FindAllStringSubmatchIndex(htmlString, -1)
+	return append(idxs, rxRomanYMD.FindAllStringSubmatchIndex(htmlString, -1)...)
+}
+
 // searchPattern runs chained candidate filtering and selection.
 func searchPattern(htmlString string, patternFinder fnRe2GoFinder, rxCatchPattern, rxYearPattern *regexp.Regexp, opts Options) (string, []string) {
 	candidates := plausibleYearFilter(htmlString, patternFinder, rxYearPattern, false, opts)
@@ -844,7 +1082,7 @@ func selectCandidate(candidates []yearCandidate, catchPattern, yearPattern *rege
 		candidates = candidates[:10]
 	}
 
-	log.Debug().Msgf("top ten occurences: %v", candidates)
+	opts.log.Debugf("top ten occurences: %v", candidates)
 
 	// Sort and find probable candidates: the best 2?
 	sort.SliceStable(candidates, func(a, b int) bool {
@@ -862,7 +1100,7 @@ func selectCandidate(candidates []yearCandidate, catchPattern, yearPattern *rege
 		bestOnes = append([]yearCandidate{}, candidates...)
 	}
 
-	log.Debug().Msgf("best ones: %v", bestOnes)
+	opts.log.Debugf("best ones: %v", bestOnes)
 
 	// Use plausability heuristics
 	nBestCandidate := len(bestOnes)
@@ -923,7 +1161,7 @@ func selectCandidate(candidates []yearCandidate, catchPattern, yearPattern *rege
 		rawString = bestOnes[idx].RawString
 		matches = catchPattern.FindStringSubmatch(patterns[idx])
 	} else {
-		log.Debug().Msgf("no suitable candidate: %d %d", years[0], years[1])
+		opts.log.Debugf("no suitable candidate: %d %d", years[0], years[1])
 	}
 
 	return rawString, matches
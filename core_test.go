@@ -18,6 +18,7 @@
 package htmldate
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"regexp"
@@ -717,7 +718,7 @@ func Test_findTime(t *testing.T) {
 	// Helper function
 	check := func(expectedOutput string, input string, tzExist bool) {
 		var output string
-		h, m, s, tz, found := findTime(input)
+		h, m, s, tz, found := findTime(input, Options{})
 		if found {
 			loc := tz
 			if loc == nil {
@@ -774,6 +775,18 @@ func Test_findTime(t *testing.T) {
 	// French format
 	check("07:08:00 +0100", "07h08 a.m. +0100", true)
 	check("19:08:00 +0100", "07h08 p.m. +0100", true)
+
+	// CJK format
+	check("19:25:00 +0000", "2021年7月13日 19時25分", false)
+	check("19:25:30 +0000", "2021年7月13日 19時25分30秒", false)
+	check("19:25:00 +0000", "2021年7月13日 下午7时25分", false)
+	check("07:25:00 +0000", "2021年7月13日 午前7時25分", false)
+	check("19:25:00 +0000", "2021년 7월 13일 오후 7시 25분", false)
+	check("19:25:00 +0000", "２０２１年７月１３日 １９時２５分", false)
+
+	// Non-ASCII digits
+	check("19:25:00 +0000", "٢٠٢١-٠٧-١٣ ١٩:٢٥", false)
+	check("19:25:00 +0000", "२०२१-०७-१३ १९:२५", false)
 }
 
 func Test_findDate(t *testing.T) {
@@ -807,7 +820,7 @@ func Test_findDate(t *testing.T) {
 func Test_compareReference(t *testing.T) {
 	opts := Options{
 		MinDate: defaultMinDate,
-		MaxDate: defaultMaxDate,
+		MaxDate: testMaxDate,
 	}
 
 	_, res := compareReference("", 0, "AAAA", opts)
@@ -828,7 +841,7 @@ func Test_selectCandidate(t *testing.T) {
 	// Initiate variables and helper function
 	rxYear := regexp.MustCompile(`^([0-9]{4})`)
 	rxCatch := regexp.MustCompile(`([0-9]{4})-([0-9]{2})-([0-9]{2})`)
-	opts := Options{MinDate: defaultMinDate, MaxDate: defaultMaxDate}
+	opts := Options{MinDate: defaultMinDate, MaxDate: testMaxDate}
 
 	// Nonsense
 	candidates := createCandidates("20208956", "20208956", "20208956",
@@ -869,7 +882,7 @@ func Test_searchPage(t *testing.T) {
 	var dt time.Time
 	opts := Options{
 		MinDate: defaultMinDate,
-		MaxDate: defaultMaxDate,
+		MaxDate: testMaxDate,
 	}
 
 	// Helper function
@@ -932,6 +945,21 @@ func Test_searchPage(t *testing.T) {
 	_, dt = searchPage(`<html><body><p> &copy; Copyright 1999-2020 Asia Pacific Star. All rights reserved.</p></body></html>`, opts)
 	assert.Equal(t, "2020-01-01", format(dt))
 
+	_, dt = searchPage(`<html><body><p>发布时间：2021年07月13日</p><p>© 2018</p></body></html>`, opts)
+	assert.Equal(t, "2021-07-13", format(dt))
+
+	_, dt = searchPage(`<html><body><p>２０２１年７月１３日</p></body></html>`, opts)
+	assert.Equal(t, "2021-07-13", format(dt))
+
+	_, dt = searchPage(`<html><body><p>기사입력 2021년 7월 13일</p></body></html>`, opts)
+	assert.Equal(t, "2021-07-13", format(dt))
+
+	_, dt = searchPage(`<html><body><p>Data wydania: 13.VII.2021</p><p>© 2018</p></body></html>`, opts)
+	assert.Equal(t, "2021-07-13", format(dt))
+
+	_, dt = searchPage(`<html><body><p>Közzétéve: 2021. VII. 13.</p></body></html>`, opts)
+	assert.Equal(t, "2021-07-13", format(dt))
+
 	_, dt = searchPage(`<html><head><link xmlns="http://www.w3.org/1999/xhtml"/></head></html>`, opts)
 	assert.Equal(t, "", format(dt))
 
@@ -941,7 +969,7 @@ func Test_searchPage(t *testing.T) {
 
 func Test_searchPattern(t *testing.T) {
 	// Variables
-	opts := Options{MinDate: defaultMinDate, MaxDate: defaultMaxDate}
+	opts := Options{MinDate: defaultMinDate, MaxDate: testMaxDate}
 
 	// First pattern, YYYY MM
 	pattern := re2go.TestSpYyyyMmPattern
@@ -1015,3 +1043,302 @@ func createCandidates(items ...string) []yearCandidate {
 
 	return candidates
 }
+
+func Test_FindCandidates(t *testing.T) {
+	// Helper function
+	find := func(htmlString string, opts Options) []Candidate {
+		doc, _ := dom.FastParse(strings.NewReader(htmlString))
+		candidates, err := FindCandidates(doc, opts)
+		assert.NoError(t, err)
+		return candidates
+	}
+
+	htmlString := `<html><head>
+	<meta property="article:published_time" content="2020-07-21T00:17:28+00:00" />
+	<meta property="article:modified_time" content="2021-04-06T06:32:14+00:00" />
+	<script type="application/ld+json">{"datePublished": "2020-07-20"}</script>
+	</head><body>
+	<time datetime="2020-07-22">July 22, 2020</time>
+	</body></html>`
+
+	// Runner-ups from every stage should be returned
+	candidates := find(htmlString, Options{UseOriginalDate: true})
+	var methods []string
+	for _, c := range candidates {
+		methods = append(methods, c.Method.String()+" "+c.Format("2006-01-02"))
+	}
+	assert.Equal(t, []string{
+		"meta 2020-07-21",
+		"json 2020-07-20",
+		"time 2020-07-22",
+		"timestamp 2020-07-21",
+		"meta 2021-04-06",
+		"free-text 2020-07-22",
+		"search-page 2020-07-20",
+	}, methods)
+
+	// Meta candidate should point to its element
+	assert.Equal(t, "meta", candidates[0].Node.Data)
+	assert.Equal(t, "article:published_time", candidates[0].Attribute)
+	assert.Equal(t, "datePublished", candidates[1].Attribute)
+	assert.Equal(t, "datetime", candidates[2].Attribute)
+	assert.Equal(t, "article:modified_time", candidates[4].Attribute)
+	assert.Less(t, candidates[4].Score, candidates[0].Score)
+
+	// URL candidate
+	candidates = find(`<html><body></body></html>`, Options{URL: "https://example.org/2017/08/30/this.html"})
+	assert.Len(t, candidates, 1)
+	assert.Equal(t, MethodUrl, candidates[0].Method)
+	assert.Nil(t, candidates[0].Node)
+
+	// Empty document
+	_, err := FindCandidates(nil, Options{})
+	assert.Error(t, err)
+}
+
+func Test_ResultMethod(t *testing.T) {
+	// Helper function
+	check := func(htmlString string, method Method, path, attribute string, opts ...Options) {
+		res := extractFromString(htmlString, opts...)
+		assert.Equal(t, method, res.Method, htmlString)
+		assert.Equal(t, path, res.ElementPath, htmlString)
+		assert.Equal(t, attribute, res.Attribute, htmlString)
+	}
+
+	useOriginalDate := Options{UseOriginalDate: true}
+
+	check(`<html><body>XYZ</body></html>`, MethodUnknown, "", "")
+
+	check(`<html><head><meta property="og:published_time" content="2017-09-01"/></head><body></body></html>`,
+		MethodMeta, "html > head > meta", "og:published_time", useOriginalDate)
+
+	check(`<html><head><meta itemprop="datePublished" content="2017-09-01"/></head><body></body></html>`,
+		MethodMeta, "html > head > meta", "datepublished", useOriginalDate)
+
+	check(`<html><head><script type="application/ld+json">{"datePublished": "2017-09-01"}</script></head></html>`,
+		MethodJson, "html > head > script", "datePublished", useOriginalDate)
+
+	check(`<html><body><div id="main"><time class="entry-date" datetime="2017-09-01">Sep 1</time></div></body></html>`,
+		MethodTime, "html > body > div#main > time.entry-date", "datetime", useOriginalDate)
+
+	check(`<html><body><p class="meta info">Published 2017-09-01</p></body></html>`,
+		MethodSelector, "html > body > p.meta.info", "", useOriginalDate)
+
+	check(`<html><body><abbr class="timestamp" data-utime="1504224000">Friday</abbr></body></html>`,
+		MethodAbbr, "html > body > abbr.timestamp", "data-utime", useOriginalDate)
+
+	check(`<html><body><p>© The Web Association 2013.</p></body></html>`,
+		MethodSearchPage, "", "")
+
+	res := extractFromURL("https://example.org/2017/08/30/this.html")
+	assert.Equal(t, MethodUrl, res.Method)
+	assert.Equal(t, "url", res.Method.String())
+}
+
+func Test_FromDocumentAll(t *testing.T) {
+	// Helper function
+	extractAll := func(r io.Reader, opts Options) Dates {
+		dates, err := FromReaderAll(r, opts)
+		assert.NoError(t, err)
+		return dates
+	}
+
+	str := `<html><head>
+	<meta property="article:modified_time" content="2021-04-06T06:32:14+00:00" />
+	<meta property="article:published_time" content="2020-07-21T00:17:28+00:00" />
+	</head><body/></html>`
+
+	dates := extractAll(strings.NewReader(str), Options{})
+	assert.Equal(t, "2020-07-21", dates.Published.Format("2006-01-02"))
+	assert.Equal(t, "2021-04-06", dates.Modified.Format("2006-01-02"))
+	assert.Equal(t, "article:published_time", dates.Published.Attribute)
+	assert.Equal(t, "article:modified_time", dates.Modified.Attribute)
+
+	// Result should be the same as extracting each date separately
+	for _, url := range []string{
+		"http://blog.python.org/2016/12/python-360-is-now-available.html",
+		"https://blog.wikimedia.org/2018/06/28/interactive-maps-now-in-your-language/",
+		"https://verfassungsblog.de/the-first-decade/",
+		"http://www.heimicke.de/chronik/zahlen-und-daten/",
+	} {
+		f := openMockFile(url)
+		dates := extractAll(f, Options{URL: url, ExtractTime: true})
+		f.Close()
+
+		published := extractMockFile(url, Options{URL: url, ExtractTime: true, UseOriginalDate: true})
+		modified := extractMockFile(url, Options{URL: url, ExtractTime: true})
+		assert.Equal(t, published, dates.Published, url)
+		assert.Equal(t, modified, dates.Modified, url)
+	}
+}
+
+func Test_FromDocumentContext(t *testing.T) {
+	url := "http://blog.python.org/2016/12/python-360-is-now-available.html"
+	f := openMockFile(url)
+	doc, err := dom.Parse(f)
+	f.Close()
+	assert.NoError(t, err)
+
+	// Uncanceled context should give the same result
+	res, err := FromDocumentContext(context.Background(), doc, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, extractMockFile(url), res)
+
+	// Canceled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err = FromDocumentContext(ctx, doc, Options{})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.True(t, res.IsZero())
+
+	// Exceeded time budget
+	res, err = FromDocumentContext(context.Background(), doc, Options{Timeout: time.Nanosecond})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, res.IsZero())
+
+	// Canceled in the middle of extensive search, so copyright year is used
+	str := `<html><body><p>It could be 2015-04-30.</p><p>© The Web Association 2013.</p></body></html>`
+	ctx, cancel = context.WithCancel(context.Background())
+	opts := Options{MinDate: defaultMinDate, MaxDate: testMaxDate, ctx: ctx}
+	_, dt := searchPage(str, opts)
+	assert.Equal(t, "2015-04-30", dt.Format("2006-01-02"))
+
+	cancel()
+	_, dt = searchPage(str, opts)
+	assert.Equal(t, "2013-01-01", dt.Format("2006-01-02"))
+}
+
+func Test_OptionsNow(t *testing.T) {
+	str := `<html><head>
+		<meta property="article:published_time" content="2016-06-01"/>
+	</head><body></body></html>`
+
+	// Helper function
+	extract := func(now time.Time) Result {
+		res, err := FromReader(strings.NewReader(str), Options{Now: now, SkipExtensiveSearch: true})
+		assert.NoError(t, err)
+		return res
+	}
+
+	// Date is in the future of crawl date, so it's rejected
+	res := extract(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.True(t, res.IsZero())
+
+	// Date is acceptable for the crawl date
+	res = extract(time.Date(2016, 7, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2016-06-01", res.DateTime.Format("2006-01-02"))
+
+	// Explicit max date takes precedence
+	res, _ = FromReader(strings.NewReader(str), Options{
+		Now:                 time.Date(2016, 7, 1, 0, 0, 0, 0, time.UTC),
+		MaxDate:             time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC),
+		SkipExtensiveSearch: true,
+	})
+	assert.True(t, res.IsZero())
+
+	// Min date after the default max date is not valid
+	_, err := FromReader(strings.NewReader(str), Options{
+		Now:     time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+		MinDate: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+}
+
+func Test_RelativeDates(t *testing.T) {
+	now := time.Date(2020, 5, 10, 12, 0, 0, 0, time.UTC)
+	opts := Options{Now: now, RelativeDates: true}
+
+	// Helper function
+	extract := func(str string, opts Options) Result {
+		res, err := FromReader(strings.NewReader(str), opts)
+		assert.NoError(t, err)
+		return res
+	}
+
+	// Relative expressions in various languages
+	relativeTests := map[string]string{
+		"3 hours ago":    "2020-05-10",
+		"yesterday":      "2020-05-09",
+		"gestern":        "2020-05-09",
+		"vor 3 Tagen":    "2020-05-07",
+		"il y a 2 jours": "2020-05-08",
+		"hace 5 minutos": "2020-05-10",
+	}
+
+	for expr, expected := range relativeTests {
+		str := `<html><body><p>Hello world</p><span class="date">` + expr + `</span></body></html>`
+		res := extract(str, opts)
+		assert.Equal(t, expected, res.Format("2006-01-02"), expr)
+		assert.True(t, res.Relative, expr)
+		assert.Equal(t, MethodSelector, res.Method, expr)
+		assert.Equal(t, expr, res.SrcString, expr)
+	}
+
+	// Disabled by default
+	str := `<html><body><span class="date">3 hours ago</span></body></html>`
+	assert.True(t, extract(str, Options{Now: now}).IsZero())
+
+	// Future expression is ignored
+	str = `<html><body><span class="date">in 2 days</span></body></html>`
+	assert.True(t, extract(str, opts).IsZero())
+
+	// Absolute date is preferred, even if it's found later
+	str = `<html><body>
+		<span class="date">3 hours ago</span>
+		<span class="date">2020-05-01</span>
+	</body></html>`
+	res := extract(str, opts)
+	assert.Equal(t, "2020-05-01", res.Format("2006-01-02"))
+	assert.False(t, res.Relative)
+
+	// Extractor with its own date parser resolves relative expressions as well
+	e, err := NewExtractor(opts)
+	assert.NoError(t, err)
+
+	str = `<html><body><span class="date">vor 3 Tagen</span></body></html>`
+	res, err = e.WithOwnDateParser().Extract(context.Background(), strings.NewReader(str))
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-05-07", res.Format("2006-01-02"))
+	assert.True(t, res.Relative)
+}
+
+func Test_NonASCIIDigits(t *testing.T) {
+	opts := Options{UseOriginalDate: true, SkipExtensiveSearch: true}
+
+	// Helper function
+	check := func(expected string, method Method, str string) {
+		res, err := FromReader(strings.NewReader(str), opts)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, res.Format("2006-01-02"), str)
+		assert.Equal(t, method, res.Method, str)
+	}
+
+	// Date selectors
+	check("2021-07-13", MethodSelector, `<html><body><p class="date">١٣/٠٧/٢٠٢١</p></body></html>`)
+	check("2021-07-13", MethodSelector, `<html><body><p class="date">१३/०७/२०२१</p></body></html>`)
+	check("2021-07-13", MethodSelector, `<html><body><p class="date">２０２１年７月１３日</p></body></html>`)
+	check("2021-07-13", MethodSelector, `<html><body><p class="date">٢٠٢١-٠٧-١٣ نشر</p></body></html>`)
+
+	// Title elements
+	check("2021-07-13", MethodTitle, `<html><body><h1>أخبار ١٣/٠٧/٢٠٢١</h1></body></html>`)
+	check("2021-07-13", MethodTitle, `<html><body><h1>समाचार १३/०७/२०२१</h1></body></html>`)
+	check("2021-07-13", MethodTitle, `<html><body><h1>２０２１年７月１３日のニュース</h1></body></html>`)
+}
+
+func Test_CjkDates(t *testing.T) {
+	opts := Options{UseOriginalDate: true, SkipExtensiveSearch: true}
+
+	// Helper function
+	check := func(expected string, str string) {
+		res, err := FromReader(strings.NewReader(str), opts)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, res.Format("2006-01-02"), str)
+		assert.Equal(t, MethodSelector, res.Method, str)
+	}
+
+	// Date followed by trailing words in fast mode
+	check("2021-07-13", `<html><body><div class="date">2021年7月13日 星期二 来源：新华网</div></body></html>`)
+	check("2021-07-13", `<html><body><div class="date">２０２１年７月１３日 配信</div></body></html>`)
+	check("2021-07-13", `<html><body><span class="date">2021년 7월 13일 오후 기사입력</span></body></html>`)
+}
@@ -0,0 +1,271 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	nurl "net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	dps "github.com/markusmobius/go-dateparser"
+	"golang.org/x/net/html"
+)
+
+// dateOrder is the order of day and month in numeric date, e.g. 03/04/2021.
+type dateOrder int
+
+const (
+	dateOrderUnknown dateOrder = iota
+	dateOrderDMY
+	dateOrderMDY
+)
+
+var (
+	rxNumericDate = regexp.MustCompile(`(?:^|\D)(\d{1,2})[/.-](\d{1,2})[/.-](\d{4}|\d{2})(?:\D|$)`)
+
+	// hiddenTextTags is the elements whose text is not shown in the page, e.g. analytics
+	// or JSON data, so its numeric dates can't tell the order of the visible text.
+	hiddenTextTags = sliceToMap("script", "style", "noscript")
+
+	// mdyRegions is the regions where month is written before day.
+	mdyRegions = sliceToMap("US", "PH", "FM", "MH", "PW")
+
+	// mixedRegions is the regions where numeric date is usually written year first or
+	// in mixed order, so it can't be used as evidence.
+	mixedRegions = sliceToMap("CA", "CN", "HU", "JP", "KP", "KR", "LT", "MN", "SE", "TW", "ZA")
+
+	// dmyLanguages is the languages where day is written before month in every region.
+	dmyLanguages = sliceToMap("cs", "da", "de", "el", "es", "fi", "fr", "id", "it",
+		"nl", "no", "pl", "pt", "ro", "ru", "sk", "tr", "uk", "vi")
+
+	// mdyTLDs is the top level domains that used by US institutions.
+	mdyTLDs = sliceToMap("gov", "mil", "us")
+
+	// genericCcTLDs is the country code TLDs that commonly used as generic domains, so
+	// they don't tell the region of the page.
+	genericCcTLDs = sliceToMap("ai", "cc", "co", "fm", "gg", "io", "ly", "me", "tv", "ws")
+)
+
+// detectDateOrder decides whether the ambiguous numeric dates (e.g. 03/04/2021) in the
+// page are written day or month first. The evidence are checked from the most reliable:
+// the date order in `DateParserConfig`, the unambiguous numeric dates in the page, the
+// region of page locale, the TLD of page URL and finally the page languages.
+func detectDateOrder(doc *html.Node, opts Options) dateOrder {
+	if order := configDateOrder(opts.dateParserConfig()); order != dateOrderUnknown {
+		return order
+	}
+
+	if order := pageDateOrder(doc); order != dateOrderUnknown {
+		return order
+	}
+
+	locales := opts.Languages
+	if len(locales) == 0 {
+		locales = pageLocales(doc)
+	}
+
+	if order := localeDateOrder(locales); order != dateOrderUnknown {
+		return order
+	}
+
+	if order := urlDateOrder(opts.URL); order != dateOrderUnknown {
+		return order
+	}
+
+	if len(opts.languages) > 0 {
+		if _, exist := dmyLanguages[opts.languages[0]]; exist {
+			return dateOrderDMY
+		}
+	}
+
+	return dateOrderUnknown
+}
+
+// configDateOrder returns the date order that explicitly set in `dateparser` config.
+func configDateOrder(cfg *dps.Configuration) dateOrder {
+	if cfg == nil || cfg.DateOrder == nil {
+		return dateOrderUnknown
+	}
+
+	var locale string
+	if len(cfg.Locales) > 0 {
+		locale = cfg.Locales[0]
+	}
+
+	// Year first order (e.g. "YMD") is not used for numeric date with year in the end
+	order := strings.ToUpper(cfg.DateOrder(locale))
+	dayIdx := strings.IndexByte(order, 'D')
+	monthIdx := strings.IndexByte(order, 'M')
+
+	switch {
+	case strings.HasPrefix(order, "Y"), dayIdx < 0, monthIdx < 0:
+		return dateOrderUnknown
+	case dayIdx < monthIdx:
+		return dateOrderDMY
+	default:
+		return dateOrderMDY
+	}
+}
+
+// pageDateOrder looks for the unambiguous numeric dates in the visible page text, i.e.
+// where either day or month is over 12, then returns the order that used by most of them.
+func pageDateOrder(doc *html.Node) dateOrder {
+	var nDMY, nMDY int
+	var countDates func(*html.Node)
+	countDates = func(node *html.Node) {
+		if node.Type == html.ElementNode && inMap(node.Data, hiddenTextTags) {
+			return
+		}
+
+		if node.Type == html.TextNode {
+			for _, parts := range rxNumericDate.FindAllStringSubmatch(normalizeDigits(node.Data), -1) {
+				// Only use full year, since short one might be something else, e.g. version
+				if len(parts[3]) != 4 {
+					continue
+				}
+
+				first, _ := strconv.Atoi(parts[1])
+				second, _ := strconv.Atoi(parts[2])
+				switch {
+				case first > 12 && first <= 31 && second >= 1 && second <= 12:
+					nDMY++
+				case second > 12 && second <= 31 && first >= 1 && first <= 12:
+					nMDY++
+				}
+			}
+		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			countDates(child)
+		}
+	}
+	countDates(doc)
+
+	switch {
+	case nDMY > nMDY:
+		return dateOrderDMY
+	case nMDY > nDMY:
+		return dateOrderMDY
+	default:
+		return dateOrderUnknown
+	}
+}
+
+// localeDateOrder returns the date order from the region of the first language tag
+// that has one, e.g. "en-US" or "en_GB".
+func localeDateOrder(tags []string) dateOrder {
+	for _, tag := range tags {
+		parts := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+		for _, part := range parts[min(len(parts), 1):] {
+			if len(part) == 2 && !strings.ContainsFunc(part, isNotLetter) {
+				return regionDateOrder(strings.ToUpper(part))
+			}
+		}
+	}
+
+	return dateOrderUnknown
+}
+
+func isNotLetter(r rune) bool {
+	return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z')
+}
+
+// urlDateOrder returns the date order from the TLD of the URL.
+func urlDateOrder(url string) dateOrder {
+	parsedURL, err := nurl.Parse(url)
+	if err != nil {
+		return dateOrderUnknown
+	}
+
+	host := strings.ToLower(parsedURL.Hostname())
+	tld := host[strings.LastIndexByte(host, '.')+1:]
+	if _, exist := mdyTLDs[tld]; exist {
+		return dateOrderMDY
+	}
+
+	if _, generic := genericCcTLDs[tld]; generic || len(tld) != 2 || host == tld {
+		return dateOrderUnknown
+	}
+
+	if tld == "uk" {
+		tld = "gb"
+	}
+
+	return regionDateOrder(strings.ToUpper(tld))
+}
+
+// regionDateOrder returns the date order that commonly used in the region.
+func regionDateOrder(region string) dateOrder {
+	if _, exist := mdyRegions[region]; exist {
+		return dateOrderMDY
+	}
+
+	if _, exist := mixedRegions[region]; exist {
+		return dateOrderUnknown
+	}
+
+	return dateOrderDMY
+}
+
+// applyDateOrder returns copy of options where the external `dateparser` uses the same
+// date order as the fast parser, unless it's already specified by user.
+func (opts Options) applyDateOrder() Options {
+	cfg := opts.parserConfig
+	if cfg == nil || cfg.DateOrder != nil {
+		return opts
+	}
+
+	switch opts.dateOrder {
+	case dateOrderDMY:
+		opts.parserConfig = cfg.Clone()
+		opts.parserConfig.DateOrder = dps.DMY
+	case dateOrderMDY:
+		opts.parserConfig = cfg.Clone()
+		opts.parserConfig.DateOrder = dps.MDY
+	}
+
+	return opts
+}
+
+// orderDayMonth returns the day and month of numeric date where the first component is
+// captured as day, following the date order of the page.
+func orderDayMonth(day, month int, opts Options) (int, int) {
+	if opts.dateOrder == dateOrderMDY && day <= 12 {
+		return month, day
+	}
+	return trySwapValues(day, month)
+}
+
+// isAmbiguousDate checks if the date is written as numeric date in the raw string, where
+// both day and month are at most 12 so the order can't be told from the date itself.
+func isAmbiguousDate(rawString string, date time.Time) bool {
+	_, month, day := date.Date()
+	for _, parts := range rxNumericDate.FindAllStringSubmatch(normalizeDigits(rawString), -1) {
+		first, _ := strconv.Atoi(parts[1])
+		second, _ := strconv.Atoi(parts[2])
+		year, _ := strconv.Atoi(parts[3])
+		if first == second || first > 12 || second > 12 || correctYear(year) != date.Year() {
+			continue
+		}
+
+		if (first == day && second == int(month)) || (first == int(month) && second == day) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+package htmldate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-shiori/dom"
+	dps "github.com/markusmobius/go-dateparser"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_detectDateOrder(t *testing.T) {
+	// Helper function
+	detect := func(htmlString string, opts Options) dateOrder {
+		doc, err := dom.Parse(strings.NewReader(htmlString))
+		assert.NoError(t, err)
+
+		opts, err = prepareOptions(opts)
+		assert.NoError(t, err)
+
+		_, opts, err = prepareDocument(doc, opts)
+		assert.NoError(t, err)
+		return opts.dateOrder
+	}
+
+	// Nothing to tell
+	assert.Equal(t, dateOrderUnknown, detect(`<html><body>03/04/2021</body></html>`, Options{}))
+	assert.Equal(t, dateOrderUnknown, detect(`<html lang="en"><body></body></html>`, Options{}))
+	assert.Equal(t, dateOrderUnknown, detect(`<html lang="ja-JP"><body></body></html>`, Options{}))
+	assert.Equal(t, dateOrderUnknown, detect(`<html><body></body></html>`, Options{URL: "https://example.com/"}))
+	assert.Equal(t, dateOrderUnknown, detect(`<html><body></body></html>`, Options{URL: "https://example.io/"}))
+
+	// Explicit date order in dateparser config
+	cfg := &dps.Configuration{DateOrder: dps.MDY}
+	assert.Equal(t, dateOrderMDY, detect(`<html lang="en-GB"><body>25/12/2020</body></html>`,
+		Options{DateParserConfig: cfg}))
+	cfg = &dps.Configuration{DateOrder: dps.YMD}
+	assert.Equal(t, dateOrderDMY, detect(`<html lang="en-GB"><body></body></html>`,
+		Options{DateParserConfig: cfg}))
+
+	// Unambiguous dates in the page
+	assert.Equal(t, dateOrderDMY, detect(`<html lang="en-US"><body>
+		<p>25/12/2020</p><p>03/04/2021</p>
+	</body></html>`, Options{}))
+	assert.Equal(t, dateOrderMDY, detect(`<html lang="en-GB"><body>
+		<p>12/25/2020</p><p>12.24.2020</p><p>25/12/2020</p><p>03/04/2021</p>
+	</body></html>`, Options{}))
+	assert.Equal(t, dateOrderUnknown, detect(`<html><body>
+		<p>Version 1.13.20</p><p>03/04/2021</p>
+	</body></html>`, Options{}))
+	assert.Equal(t, dateOrderMDY, detect(`<html lang="en-US"><head>
+		<style>/* 25.12.2020 */</style>
+		<script>dataLayer.push({publishDate: '25/12/2020', modifiedDate: '26/12/2020'});</script>
+	</head><body>
+		<noscript>25/12/2020</noscript><p>03/04/2021</p>
+	</body></html>`, Options{}))
+
+	// Region of the page locale
+	assert.Equal(t, dateOrderMDY, detect(`<html lang="en-US"><body></body></html>`, Options{}))
+	assert.Equal(t, dateOrderDMY, detect(`<html lang="en-GB"><body></body></html>`, Options{}))
+	assert.Equal(t, dateOrderDMY, detect(`<html><head>
+		<meta property="og:locale" content="en_AU"/>
+	</head></html>`, Options{}))
+	assert.Equal(t, dateOrderMDY, detect(`<html lang="en-GB"><body></body></html>`,
+		Options{Languages: []string{"en-US"}}))
+
+	// TLD of the URL
+	assert.Equal(t, dateOrderDMY, detect(`<html><body></body></html>`, Options{URL: "https://www.bbc.co.uk/news"}))
+	assert.Equal(t, dateOrderMDY, detect(`<html><body></body></html>`, Options{URL: "https://www.nasa.gov/news"}))
+	assert.Equal(t, dateOrderDMY, detect(`<html lang="en"><body></body></html>`, Options{URL: "https://www.abc.net.au/"}))
+
+	// Page languages
+	assert.Equal(t, dateOrderDMY, detect(`<html lang="de"><body></body></html>`, Options{}))
+	assert.Equal(t, dateOrderDMY, detect(`<html><body></body></html>`, Options{Languages: []string{"fr"}}))
+}
+
+func Test_DateOrder(t *testing.T) {
+	// Helper function
+	extract := func(htmlString string, opts Options) Result {
+		opts.SkipExtensiveSearch = true
+		result, err := FromReader(strings.NewReader(htmlString), opts)
+		assert.NoError(t, err)
+		return result
+	}
+
+	// Without evidence, day comes first but the result is flagged
+	result := extract(`<html><body><p class="date">03/04/2021</p></body></html>`, Options{})
+	assert.Equal(t, "2021-04-03", result.Format("2006-01-02"))
+	assert.True(t, result.AmbiguousDateOrder)
+
+	// Evidence from the page locale
+	result = extract(`<html lang="en-US"><body><p class="date">03/04/2021</p></body></html>`, Options{})
+	assert.Equal(t, "2021-03-04", result.Format("2006-01-02"))
+	assert.False(t, result.AmbiguousDateOrder)
+
+	result = extract(`<html lang="en-GB"><body><p class="date">03/04/2021</p></body></html>`, Options{})
+	assert.Equal(t, "2021-04-03", result.Format("2006-01-02"))
+	assert.False(t, result.AmbiguousDateOrder)
+
+	// Evidence from other dates in the page
+	result = extract(`<html><body>
+		<p class="date">03/04/2021</p>
+		<p class="comment">12/24/2020</p>
+	</body></html>`, Options{})
+	assert.Equal(t, "2021-03-04", result.Format("2006-01-02"))
+	assert.False(t, result.AmbiguousDateOrder)
+
+	// Evidence from the URL
+	result = extract(`<html><body><p class="date">03/04/2021</p></body></html>`,
+		Options{URL: "https://www.whitehouse.gov/briefing-room/"})
+	assert.Equal(t, "2021-03-04", result.Format("2006-01-02"))
+	assert.False(t, result.AmbiguousDateOrder)
+
+	// Roman numeral month is never swapped nor flagged
+	result = extract(`<html lang="en-US"><body><p class="date">03.VII.2021</p></body></html>`, Options{})
+	assert.Equal(t, "2021-07-03", result.Format("2006-01-02"))
+	assert.False(t, result.AmbiguousDateOrder)
+
+	// Unambiguous date is never flagged
+	result = extract(`<html><body><p class="date">13/04/2021</p></body></html>`, Options{})
+	assert.Equal(t, "2021-04-13", result.Format("2006-01-02"))
+	assert.False(t, result.AmbiguousDateOrder)
+
+	result = extract(`<html><body><p class="date">04/13/2021</p></body></html>`, Options{})
+	assert.Equal(t, "2021-04-13", result.Format("2006-01-02"))
+	assert.False(t, result.AmbiguousDateOrder)
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// epochAttrNames is the generic names of data attributes that contain epoch timestamp,
+// which used for both original and modified date. The other attributes are matched
+// using the date key vocabulary, e.g. `data-publish-date`.
+var epochAttrNames = sliceToMap("timestamp", "time", "utime", "unixtime", "epoch")
+
+// parseEpoch parses Unix epoch timestamp in seconds (10 digits) or milliseconds (13 digits).
+// Since any number could look like an epoch, only use it for values whose key or attribute
+// name marks a date.
+func parseEpoch(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if (len(s) != 10 && len(s) != 13) || !isDigit(s) {
+		return timeZero, false
+	}
+
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return timeZero, false
+	}
+
+	if len(s) == 13 {
+		return time.UnixMilli(value).UTC(), true
+	}
+	return time.Unix(value, 0).UTC(), true
+}
+
+// epochDate returns the date of Unix epoch timestamp in UTC.
+func epochDate(s string) (time.Time, bool) {
+	dt, isEpoch := parseEpoch(s)
+	if !isEpoch {
+		return timeZero, false
+	}
+
+	year, month, day := dt.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), true
+}
+
+// examineEpochAttributes scans the page for data attributes that contain epoch timestamp,
+// e.g. `data-timestamp`, `data-time` or `data-publish-date`. Like in <abbr> elements, the
+// oldest or the newest timestamp is used depending on the requested date.
+func examineEpochAttributes(doc *html.Node, opts Options) (string, time.Time) {
+	var bestText string
+	var bestDate time.Time
+
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		if node.Type == html.ElementNode {
+			for _, attr := range node.Attr {
+				if !isEpochAttr(attr.Key, opts) {
+					continue
+				}
+
+				dt, isEpoch := epochDate(attr.Val)
+				if !isEpoch || !validateDate(dt, opts) {
+					continue
+				}
+
+				opts.log.Debugf("epoch attribute %s found: %s", attr.Key, attr.Val)
+				opts.from(MethodEpoch, node, attr.Key).record(attr.Val, dt)
+
+				if bestDate.IsZero() ||
+					(opts.UseOriginalDate && dt.Before(bestDate)) ||
+					(!opts.UseOriginalDate && dt.After(bestDate)) {
+					bestText, bestDate = strings.TrimSpace(attr.Val), dt
+				}
+			}
+		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if opts.canceled() {
+				return
+			}
+			walk(child)
+		}
+	}
+
+	walk(doc)
+	return bestText, bestDate
+}
+
+// isEpochAttr checks if the attribute might contain epoch timestamp for the requested date.
+func isEpochAttr(name string, opts Options) bool {
+	name = strings.ToLower(name)
+	if !strings.HasPrefix(name, "data-") {
+		return false
+	}
+
+	name = strings.TrimPrefix(name, "data-")
+	return inMap(normalizeDateKey(name), epochAttrNames) ||
+		opts.dateKeys().match(name, opts.UseOriginalDate)
+}
@@ -0,0 +1,80 @@
+package htmldate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseEpoch(t *testing.T) {
+	// Helper function
+	check := func(s string, expected string) {
+		dt, isEpoch := parseEpoch(s)
+		if expected == "" {
+			assert.False(t, isEpoch, s)
+			return
+		}
+
+		assert.True(t, isEpoch, s)
+		assert.Equal(t, expected, dt.Format(time.RFC3339Nano), s)
+	}
+
+	check("1626185131", "2021-07-13T14:05:31Z")
+	check(" 1626185131 ", "2021-07-13T14:05:31Z")
+	check("1626185131250", "2021-07-13T14:05:31.25Z")
+	check("20210713", "")
+	check("162618513", "")
+	check("16261851312", "")
+	check("1626185131.5", "")
+	check("-162618513", "")
+	check("", "")
+}
+
+func Test_EpochTimestamp(t *testing.T) {
+	// Helper function
+	check := func(expected string, method Method, htmlString string, opts Options) {
+		res := extractFromString(htmlString, opts)
+		assert.Equal(t, expected, res.Format(time.RFC3339), htmlString)
+		assert.Equal(t, method, res.Method, htmlString)
+	}
+
+	original := Options{UseOriginalDate: true, SkipExtensiveSearch: true, ExtractTime: true}
+	modified := Options{SkipExtensiveSearch: true, ExtractTime: true}
+
+	// Data attributes
+	htmlString := `<html><body><div class="post">
+		<span class="date" data-timestamp="1626185131">13 days ago</span>
+		<span class="updated" data-time="1626271531000">12 days ago</span>
+	</div></body></html>`
+	check("2021-07-13T14:05:31Z", MethodEpoch, htmlString, original)
+	check("2021-07-14T14:05:31Z", MethodEpoch, htmlString, modified)
+	check("2021-07-13T14:05:31Z", MethodEpoch, `<html><body>
+		<article data-publish-date="1626185131"><p>Text</p></article>
+	</body></html>`, original)
+
+	// IDs are not mistaken for times
+	res := extractFromString(`<html><body>
+		<article data-id="1626185131" data-post="1626185131000"><p>Text</p></article>
+	</body></html>`, original)
+	assert.True(t, res.IsZero())
+
+	// Facebook abbr, both in seconds and milliseconds
+	check("2021-07-13T14:05:31Z", MethodAbbr, `<html><body>
+		<abbr class="timestamp" data-utime="1626185131">Tuesday</abbr>
+	</body></html>`, original)
+	check("2021-07-13T14:05:31Z", MethodAbbr, `<html><body>
+		<abbr class="timestamp" data-utime="1626185131000">Tuesday</abbr>
+	</body></html>`, original)
+
+	// JSON
+	check("2021-07-13T14:05:31Z", MethodJson, `<html><head><script type="application/ld+json">
+		{"@context": "https://schema.org", "@type": "NewsArticle", "datePublished": 1626185131}
+	</script></head><body></body></html>`, original)
+	check("2021-07-13T14:05:31Z", MethodHydration, `<html><body><script>
+		window.__INITIAL_STATE__ = {"article": {"publishedAt": 1626185131, "id": 1626185000}};
+	</script></body></html>`, original)
+	check("2021-07-13T14:05:31Z", MethodAnalytics, `<html><body><script>
+		dataLayer.push({contentId: 1626185000, publishDate: '1626185131000'});
+	</script></body></html>`, original)
+}
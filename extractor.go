@@ -0,0 +1,159 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-shiori/dom"
+	dps "github.com/markusmobius/go-dateparser"
+	"golang.org/x/net/html"
+)
+
+// Extractor is a reusable date extractor. Its options are validated and prepared once
+// when it's created, so it's cheaper to use for many documents than the package level
+// functions. It's safe for concurrent use by multiple goroutines.
+type Extractor struct {
+	opts Options
+}
+
+// NewExtractor validates the options and creates a new extractor that uses it.
+func NewExtractor(opts Options) (*Extractor, error) {
+	opts, err := prepareOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Extractor{opts: opts}, nil
+}
+
+// Options returns the options used by the extractor, with the default values applied.
+func (e *Extractor) Options() Options {
+	return e.opts
+}
+
+// WithURL returns a copy of the extractor that uses the specified URL for the page.
+// The prepared configuration is shared, so it's cheap to be called for each page.
+func (e *Extractor) WithURL(url string) *Extractor {
+	opts := e.opts
+	opts.URL = url
+	return &Extractor{opts: opts}
+}
+
+// WithOwnDateParser returns a copy of the extractor that uses its own instances of the
+// external date parsers, both for absolute and relative dates. The parsers of go-dateparser
+// serialize their calls with a mutex, so by default extractors that used in parallel wait
+// for each other. Dedicated parsers avoid that lock contention, e.g. one per tenant.
+func (e *Extractor) WithOwnDateParser() *Extractor {
+	opts := e.opts
+	opts.parser = &dps.Parser{ParserTypes: externalParser.ParserTypes}
+	opts.relativeParser = &dps.Parser{ParserTypes: relativeParser.ParserTypes}
+	return &Extractor{opts: opts}
+}
+
+// Extract extracts publish date from the specified reader.
+func (e *Extractor) Extract(ctx context.Context, r io.Reader) (Result, error) {
+	doc, err := dom.Parse(r)
+	if err != nil {
+		return resultZero, err
+	}
+
+	return e.ExtractDocument(ctx, doc)
+}
+
+// ExtractDocument extracts publish date from the specified html document. If the
+// context is done before extraction finished, it returns the best date found so far
+// along with the context error.
+func (e *Extractor) ExtractDocument(ctx context.Context, doc *html.Node) (Result, error) {
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	doc, opts, err := prepareDocument(doc, e.opts.withContext(ctx))
+	if err != nil {
+		return resultZero, err
+	}
+
+	// Extract date, while keeping track where it found
+	opts.candidates = &candidateSet{}
+	result := detectDate(&Page{doc: doc}, opts)
+	return result, ctx.Err()
+}
+
+// ExtractAll extracts both the original publish date and the last modified date from
+// the specified html document. Option `UseOriginalDate` is ignored here.
+func (e *Extractor) ExtractAll(ctx context.Context, doc *html.Node) (Dates, error) {
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	doc, opts, err := prepareDocument(doc, e.opts.withContext(ctx))
+	if err != nil {
+		return Dates{}, err
+	}
+
+	// Extract both dates from the same page
+	p := &Page{doc: doc}
+
+	publishedOpts := opts
+	publishedOpts.UseOriginalDate = true
+	publishedOpts.candidates = &candidateSet{}
+
+	modifiedOpts := opts
+	modifiedOpts.UseOriginalDate = false
+	modifiedOpts.candidates = &candidateSet{}
+
+	return Dates{
+		Published: detectDate(p, publishedOpts),
+		Modified:  detectDate(p, modifiedOpts),
+	}, ctx.Err()
+}
+
+// FindCandidates runs all extraction methods to the specified html document and
+// returns every date candidates that found, sorted from the highest score.
+func (e *Extractor) FindCandidates(ctx context.Context, doc *html.Node) ([]Candidate, error) {
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	doc, opts, err := prepareDocument(doc, e.opts.withContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Run every stages
+	candidates := &candidateSet{exhaustive: true}
+	opts.candidates = candidates
+
+	p := &Page{doc: doc}
+	for _, stage := range opts.pipeline() {
+		if opts.canceled() {
+			break
+		}
+
+		rawString, date := stage.Find(p, opts)
+		if _, found := candidates.find(stage.Method(), date); !date.IsZero() && !found {
+			opts.from(stage.Method(), nil, "").record(rawString, date)
+		}
+	}
+
+	return candidates.sorted(), ctx.Err()
+}
+
+// withTimeout applies the time budget from options to the context.
+func (e *Extractor) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if e.opts.Timeout > 0 {
+		return context.WithTimeout(ctx, e.opts.Timeout)
+	}
+	return context.WithCancel(ctx)
+}
@@ -0,0 +1,92 @@
+package htmldate
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-shiori/dom"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/html"
+)
+
+func Test_NewExtractor(t *testing.T) {
+	// Invalid options
+	_, err := NewExtractor(Options{
+		MinDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		MaxDate: time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+
+	_, err = NewExtractor(Options{Timeout: -time.Second})
+	assert.Error(t, err)
+
+	// Default values are applied
+	e, err := NewExtractor(Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultMinDate, e.Options().MinDate)
+	assert.True(t, e.Options().MaxDate.IsZero())
+
+	now := time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC)
+	e, err = NewExtractor(Options{Now: now})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultMaxDate(now), e.Options().MaxDate)
+}
+
+func Test_Extractor(t *testing.T) {
+	urls := []string{
+		"http://blog.python.org/2016/12/python-360-is-now-available.html",
+		"http://blog.kinra.de/?p=959/",
+		"http://carta.info/der-neue-trend-muss-statt-wunschkoalition/",
+		"https://futurezone.at/digital-life/wie-creativecommons-richtig-genutzt-wird/24.600.504",
+	}
+
+	// Parse the documents first
+	docs := make([]*html.Node, len(urls))
+	for i, url := range urls {
+		f := openMockFile(url)
+		doc, err := dom.Parse(f)
+		f.Close()
+		assert.NoError(t, err)
+		docs[i] = doc
+	}
+
+	// Use the same extractor concurrently, both with shared and own date parser
+	e, err := NewExtractor(Options{})
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	results := make([]Result, len(urls)*2)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			extractor := e.WithURL(urls[i%len(urls)])
+			if i >= len(urls) {
+				extractor = extractor.WithOwnDateParser()
+			}
+
+			res, err := extractor.ExtractDocument(context.Background(), docs[i%len(urls)])
+			assert.NoError(t, err)
+			results[i] = res
+		}()
+	}
+	wg.Wait()
+
+	// Results should be the same as the package level function
+	for i, res := range results {
+		assert.Equal(t, extractMockFile(urls[i%len(urls)]), res)
+	}
+
+	// Own date parser should not be shared with the original extractor
+	assert.Same(t, externalParser, e.Options().dateParser())
+	assert.NotSame(t, externalParser, e.WithOwnDateParser().Options().dateParser())
+	assert.Same(t, relativeParser, e.Options().relativeDateParser())
+	assert.NotSame(t, relativeParser, e.WithOwnDateParser().Options().relativeDateParser())
+
+	// WithURL should not change the original extractor
+	assert.Empty(t, e.Options().URL)
+	assert.Equal(t, urls[0], e.WithURL(urls[0]).Options().URL)
+}
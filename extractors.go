@@ -19,13 +19,13 @@ package htmldate
 
 import (
 	"encoding/json"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
 
 	"github.com/go-shiori/dom"
-	dps "github.com/markusmobius/go-dateparser"
 	"github.com/markusmobius/go-htmldate/internal/re2go"
 	"github.com/markusmobius/go-htmldate/internal/selector"
 	"golang.org/x/net/html"
@@ -64,7 +64,7 @@ func extractUrlDate(url string, opts Options) time.Time {
 		return timeZero
 	}
 
-	log.Debug().Msgf("found date in url: %s", parts[0])
+	opts.log.Debugf("found date in url: %s", parts[0])
 	return date
 }
 
@@ -86,26 +86,33 @@ func tryDateExpr(s string, opts Options) (string, time.Time) {
 		return s, timeZero
 	}
 
+	// Digits and Roman numeral months are normalized for parsing, while the original
+	// string is kept as source
+	normalized := normalizeRomanMonths(normalizeDigits(s))
+
 	// Check if string only contains time/single year or digits and not a date
-	if rxDiscardPattern.MatchString(s) {
+	if rxDiscardPattern.MatchString(normalized) {
 		return s, timeZero
 	}
 
-	// Try to parse date using the faster method
-	parseResult := fastParse(s, opts)
+	// Try to parse date using the faster method, making sure it matches the weekday
+	parseResult, calendar := fastParseCalendar(s, opts)
+	parseResult = checkWeekday(s, parseResult, opts)
 	if !parseResult.IsZero() {
+		opts.inCalendar(calendar).record(s, parseResult)
 		return s, parseResult
 	}
 
 	// Use slow but extensive search, using dateparser
 	if !opts.SkipExtensiveSearch {
 		// Additional filters to prevent computational cost
-		if !rxTextDatePattern.MatchString(s) {
+		if !rxTextDatePattern.MatchString(normalized) {
 			return s, timeZero
 		}
 
-		dt := externalDateParser(s, opts)
+		dt := checkWeekday(s, externalDateParser(normalized, opts), opts)
 		if !dt.IsZero() {
+			opts.record(s, dt)
 			return s, dt
 		}
 	}
@@ -113,10 +120,40 @@ func tryDateExpr(s string, opts Options) (string, time.Time) {
 	return s, timeZero
 }
 
+// tryStructuredDate tries to extract date from value of structured metadata, e.g. <meta>
+// content or JSON-LD. Since those values are mostly written in ISO 8601, they are parsed
+// by the dedicated ISO parser first, and only handed to the free text heuristics when
+// they are written in other format.
+func tryStructuredDate(s string, opts Options) (string, time.Time) {
+	s = normalizeSpaces(s)
+	date, isISO := isoDate(s)
+	if !isISO {
+		return tryDateExpr(s, opts)
+	}
+
+	if !validateDate(date, opts) {
+		return s, timeZero
+	}
+
+	opts.log.Debugf("found ISO 8601 date: %s", s)
+	opts.record(s, date)
+	return s, date
+}
+
 // fastParse parse the string into time.Time.
 // In the original Python library, this function is named `custom_parse`, but I
 // renamed it to `fastParse` because I think it's more suitable to its purpose.
 func fastParse(s string, opts Options) time.Time {
+	dt, _ := fastParseCalendar(s, opts)
+	return dt
+}
+
+// fastParseCalendar is like `fastParse`, but also returns the calendar that the date is
+// converted from.
+func fastParseCalendar(s string, opts Options) (time.Time, Calendar) {
+	original := s
+	s = normalizeRomanMonths(normalizeDigits(s))
+
 	// 1. Try YYYYMMDD without regex first
 	// This also handle '201709011234' which not covered by dateparser
 	if len(s) >= 8 && isDigit(s[4:8]) {
@@ -125,8 +162,8 @@ func fastParse(s string, opts Options) time.Time {
 		day, _ := strconv.Atoi(s[6:8])
 
 		if dt, valid := validateDateParts(year, month, day, opts); valid {
-			log.Debug().Msgf("fast parse found Y-M-D without separator: %s", s[:8])
-			return dt
+			opts.log.Debugf("fast parse found Y-M-D without separator: %s", s[:8])
+			return dt, CalendarGregorian
 		}
 	}
 
@@ -139,8 +176,8 @@ func fastParse(s string, opts Options) time.Time {
 		day, _ := strconv.Atoi(text[6:8])
 
 		if dt, valid := validateDateParts(year, month, day, opts); valid {
-			log.Debug().Msgf("fast parse found Y-M-D without separator: %s", s[:8])
-			return dt
+			opts.log.Debugf("fast parse found Y-M-D without separator: %s", s[:8])
+			return dt, CalendarGregorian
 		}
 	}
 
@@ -153,18 +190,40 @@ func fastParse(s string, opts Options) time.Time {
 
 		if lastMatchedName != "day" { // handle D-M-Y formats
 			year = correctYear(year)
-			day, month = trySwapValues(day, month)
+			day, month = orderDayMonth(day, month, opts)
 		}
 
 		// Make sure month is at most 12, because if not then it's not YMD
 		dt, valid := validateDateParts(year, month, day, opts)
 		if valid {
-			log.Debug().Msgf("fast parse found Y-M-D date: %s", s)
-			return dt
+			opts.log.Debugf("fast parse found Y-M-D date: %s", s)
+			return dt, CalendarGregorian
+		}
+	}
+
+	// 4. Try the CJK patterns, e.g. 2021年7月13日 or 2021년 7월 13일
+	if parts := rxCjkDate.FindStringSubmatch(s); len(parts) > 0 {
+		year, _ := strconv.Atoi(parts[1])
+		month, _ := strconv.Atoi(parts[2])
+		day := 1
+		if parts[3] != "" {
+			day, _ = strconv.Atoi(parts[3])
 		}
+
+		dt, valid := validateDateParts(year, month, day, opts)
+		if valid {
+			opts.log.Debugf("fast parse found CJK date: %s", s)
+			return dt, CalendarGregorian
+		}
+	}
+
+	// 5. Try the non-Gregorian calendars, e.g. Jalali, Hijri, Thai and Japanese era
+	if dt, calendar := calendarParse(original, opts); calendar != CalendarGregorian {
+		opts.log.Debugf("fast parse found %s date: %s", calendar, s)
+		return dt, calendar
 	}
 
-	// 4. Try the Y-M and M-Y patterns
+	// 6. Try the Y-M and M-Y patterns
 	namedParts, _ = rxFindNamedStringSubmatch(rxYmPattern, s)
 	if len(namedParts) != 0 {
 		year, _ := strconv.Atoi(namedParts["year"])
@@ -173,32 +232,25 @@ func fastParse(s string, opts Options) time.Time {
 		// Make sure month is at most 12, because if not then it's not D-M-Y
 		dt, valid := validateDateParts(year, month, 1, opts)
 		if valid {
-			log.Debug().Msgf("fast parse found Y-M date: %s", s)
-			return dt
+			opts.log.Debugf("fast parse found Y-M date: %s", s)
+			return dt, CalendarGregorian
 		}
 	}
 
-	// 5. Try the other regex pattern
+	// 7. Try the other regex pattern
 	dt := regexParse(s, opts)
 	if validateDate(dt, opts) {
-		log.Debug().Msgf("fast parse found regex date: %s", dt.Format("2006-01-02"))
-		return dt
+		opts.log.Debugf("fast parse found regex date: %s", dt.Format("2006-01-02"))
+		return dt, CalendarGregorian
 	}
 
-	log.Error().Msgf("failed to parse \"%s\"", s)
-	return timeZero
+	opts.log.Debugf("failed to parse \"%s\"", s)
+	return timeZero, CalendarGregorian
 }
 
 // externalDateParser uses go-dateparser package to extensively look for date.
 func externalDateParser(s string, opts Options) time.Time {
-	var cfg *dps.Configuration
-	if opts.DateParserConfig != nil {
-		cfg = opts.DateParserConfig
-	} else {
-		cfg = externalDpsConfig
-	}
-
-	dt, _ := externalParser.Parse(cfg, s)
+	dt, _ := opts.dateParser().Parse(opts.dateParserConfig(), s)
 	if validateDate(dt.Time, opts) {
 		return dt.Time
 	}
@@ -206,102 +258,185 @@ func externalDateParser(s string, opts Options) time.Time {
 	return timeZero
 }
 
-// jsonSearch looks for JSON time patterns in JSON sections of the document.
-func jsonSearch(doc *html.Node, opts Options) (string, time.Time) {
-	// Prepare targetKeys to look for
-	var targetKeys map[string]struct{}
-	if opts.UseOriginalDate {
-		targetKeys = sliceToMap("datePublished", "dateCreated")
-	} else {
-		targetKeys = sliceToMap("dateModified")
+// tryRelativeDateExpr tries to resolve relative date expression (e.g. "3 hours ago" or
+// "gestern") against the reference time of extraction.
+func tryRelativeDateExpr(s string, opts Options) (string, time.Time) {
+	// Relative expression is usually short and has few digits
+	s = normalizeSpaces(s)
+	if n := utf8.RuneCountInString(s); n < 3 || n > maxRelativeLen || getDigitCount(s) > 2 {
+		return s, timeZero
 	}
 
-	// Prepare function to capture date texts recursively
-	var capturedTexts []jsonCapturedText
-	var findDateTexts func(obj map[string]interface{})
-	findDateTexts = func(obj map[string]interface{}) {
-		for key, value := range obj {
-			switch v := value.(type) {
-			case string:
-				if inMap(key, targetKeys) {
-					capturedTexts = append(capturedTexts, jsonCapturedText{
-						Key:  key,
-						Text: normalizeSpaces(v),
-					})
-				}
-
-			case map[string]interface{}:
-				findDateTexts(v)
-
-			case []interface{}:
-				for _, item := range v {
-					itemObject, isObject := item.(map[string]interface{})
-					if isObject {
-						findDateTexts(itemObject)
-					}
-				}
-			}
-		}
+	cfg := opts.dateParserConfig()
+	dt, err := opts.relativeDateParser().Parse(cfg, normalizeDigits(s))
+	if err != nil || dt.IsZero() {
+		return s, timeZero
+	}
+
+	// Publish date can't be in the future
+	if !cfg.CurrentTime.IsZero() && dt.Time.After(cfg.CurrentTime) {
+		return s, timeZero
+	}
+
+	date := time.Date(dt.Time.Year(), dt.Time.Month(), dt.Time.Day(), 0, 0, 0, 0, time.UTC)
+	if !validateDate(date, opts) {
+		return s, timeZero
 	}
 
+	opts.log.Debugf("relative date found: %s => %s", s, date.Format("2006-01-02"))
+	opts.record(s, date)
+	return s, date
+}
+
+// jsonSearch looks for JSON time patterns in JSON sections of the document.
+func jsonSearch(doc *html.Node, opts Options) (string, time.Time) {
+	return selectJsonDate(findJsonDateTexts(doc, opts), opts)
+}
+
+// findJsonDateTexts collects the date texts in JSON sections of the document, both
+// for the original and modified date. Each text is marked with the JSON-LD entity where
+// it found, ranked by how likely it describes the page itself.
+func findJsonDateTexts(doc *html.Node, opts Options) []jsonCapturedText {
 	// Look throughout the HTML tree
 	ldJsonScripts := dom.QuerySelectorAll(doc, `script[type="application/ld+json"]`)
 	settingsJsonScripts := dom.QuerySelectorAll(doc, `script[type="application/settings+json"]`)
 	scriptNodes := append(ldJsonScripts, settingsJsonScripts...)
 
+	// Collect entities from all scripts, since they might refer each other
+	graph := newJsonLdGraph(opts.URL)
 	for _, elem := range scriptNodes {
-		// Get the json text inside the script
-		jsonText := dom.TextContent(elem)
-		jsonText = strings.TrimSpace(jsonText)
-		log.Debug().Msgf("found JSON: %s", strLimit(jsonText, 200))
-
-		// First, decode JSON text assuming it as array of object
-		var err error
-		arrayData := []map[string]interface{}{}
-		err = json.Unmarshal([]byte(jsonText), &arrayData)
-		if err == nil {
-			for _, data := range arrayData {
-				findDateTexts(data)
-			}
-			continue
+		for _, obj := range decodeJsonScript(dom.TextContent(elem), opts) {
+			graph.add(obj, elem, nil, false)
 		}
+	}
 
-		// If it's not array, decode JSON text assuming it as an object
-		// There are some web pages whose JSON+LD contains additional trailing closing bracket
-		// which make JSON decoder failed. So, here if the JSON decoder failed we'll remove
-		// the last trailing bracket then try again.
-		objData := map[string]interface{}{}
-		for {
-			err = json.Unmarshal([]byte(jsonText), &objData)
-			if err == nil {
-				break
+	// Capture the date texts of each entity
+	var capturedTexts []jsonCapturedText
+	for _, entity := range graph.entities {
+		rank := graph.rank(entity)
+		for _, key := range jsonLdKeys(entity.data) {
+			if !inMap(key, jsonOriginalKeys) && !inMap(key, jsonModifiedKeys) {
+				continue
 			}
 
-			tmp := rxLastJsonBracket.ReplaceAllString(jsonText, "")
-			if tmp == jsonText {
-				break
+			// Number is only accepted as epoch timestamp
+			var text string
+			switch v := entity.data[key].(type) {
+			case string:
+				text = v
+			case float64:
+				text = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				continue
 			}
 
-			jsonText = tmp
+			capturedTexts = append(capturedTexts, jsonCapturedText{
+				Key:        key,
+				Text:       normalizeSpaces(text),
+				Node:       entity.node,
+				EntityType: entity.entityName(),
+				EntityID:   entity.id,
+				Rank:       rank,
+			})
 		}
+	}
 
+	return capturedTexts
+}
+
+// decodeJsonScript decodes the JSON text inside the script into list of objects.
+func decodeJsonScript(jsonText string, opts Options) []map[string]interface{} {
+	jsonText = strings.TrimSpace(jsonText)
+	opts.log.Debugf("found JSON: %s", strLimit(jsonText, 200))
+
+	// First, decode JSON text assuming it as array of object
+	var err error
+	arrayData := []map[string]interface{}{}
+	err = json.Unmarshal([]byte(jsonText), &arrayData)
+	if err == nil {
+		return arrayData
+	}
+
+	// If it's not array, decode JSON text assuming it as an object
+	// There are some web pages whose JSON+LD contains additional trailing closing bracket
+	// which make JSON decoder failed. So, here if the JSON decoder failed we'll remove
+	// the last trailing bracket then try again.
+	objData := map[string]interface{}{}
+	for {
+		err = json.Unmarshal([]byte(jsonText), &objData)
 		if err == nil {
-			findDateTexts(objData)
-			continue
+			break
 		}
 
-		// At this point JSON decoder has failed
-		log.Debug().Msgf("failed to decode JSON: %v", err)
+		tmp := rxLastJsonBracket.ReplaceAllString(jsonText, "")
+		if tmp == jsonText {
+			break
+		}
+
+		jsonText = tmp
+	}
+
+	if err == nil {
+		return []map[string]interface{}{objData}
+	}
+
+	// At this point JSON decoder has failed
+	opts.log.Debugf("failed to decode JSON: %v", err)
+	return nil
+}
+
+// parseStructuredValue parses the date value in structured data like JSON or script
+// objects, along with the calendar it's converted from. Values are parsed as epoch or
+// ISO 8601 first, and only use heuristics of the fast parser for the other format.
+func parseStructuredValue(s string, opts Options) (time.Time, Calendar) {
+	if dt, isEpoch := epochDate(s); isEpoch {
+		return dt, CalendarGregorian
+	}
+
+	if dt, isISO := isoDate(s); isISO {
+		return dt, CalendarGregorian
+	}
+
+	return fastParseCalendar(s, opts)
+}
+
+// selectJsonDate parses the captured JSON texts that relevant for the requested date
+// and returns the best one. Only the dates from the best ranked entities are compared,
+// so dates of comment or related article can't win over the page itself.
+func selectJsonDate(capturedTexts []jsonCapturedText, opts Options) (string, time.Time) {
+	// Prepare targetKeys to look for
+	targetKeys := jsonModifiedKeys
+	if opts.UseOriginalDate {
+		targetKeys = jsonOriginalKeys
 	}
 
+	// Check the best ranked entities first
+	capturedTexts = append([]jsonCapturedText{}, capturedTexts...)
+	sort.SliceStable(capturedTexts, func(a, b int) bool {
+		return capturedTexts[a].Rank > capturedTexts[b].Rank
+	})
+
 	// Parse date for each captured texts
 	var dates []jsonCapturedDate
 	for _, capturedText := range capturedTexts {
-		dt := fastParse(capturedText.Text, opts)
+		if !inMap(capturedText.Key, targetKeys) || capturedText.Rank == jsonRankExcluded {
+			continue
+		}
+
+		dt, calendar := parseStructuredValue(capturedText.Text, opts)
 		if validateDate(dt, opts) {
+			jsonOpts := opts.from(MethodJson, capturedText.Node, capturedText.Key).
+				inEntity(capturedText.EntityType, capturedText.EntityID).
+				inCalendar(calendar)
+			if len(dates) > 0 && capturedText.Rank < dates[0].Rank {
+				jsonOpts = jsonOpts.asReserve()
+			}
+
+			jsonOpts.record(capturedText.Text, dt)
 			dates = append(dates, jsonCapturedDate{
 				Text: capturedText.Text,
 				Date: dt,
+				Rank: capturedText.Rank,
 			})
 		}
 	}
@@ -310,11 +445,15 @@ func jsonSearch(doc *html.Node, opts Options) (string, time.Time) {
 		return "", timeZero
 	}
 
-	log.Debug().Msgf("captured dates: %v", dates)
+	opts.log.Debugf("captured dates: %v", dates)
 
 	// Find the best date
 	var best jsonCapturedDate
 	for _, cd := range dates {
+		if cd.Rank < dates[0].Rank {
+			break
+		}
+
 		if best.Date.IsZero() ||
 			(opts.UseOriginalDate && cd.Date.Before(best.Date)) ||
 			(!opts.UseOriginalDate && cd.Date.After(best.Date)) {
@@ -346,7 +485,7 @@ func idiosyncrasiesSearch(htmlString string, opts Options) (string, time.Time) {
 		day, _ := strconv.Atoi(parts[1])
 
 		year = correctYear(year)
-		day, month = trySwapValues(day, month)
+		day, month = orderDayMonth(day, month, opts)
 		candidate, _ = validateDateParts(year, month, day, opts)
 	}
 
@@ -358,7 +497,7 @@ func idiosyncrasiesSearch(htmlString string, opts Options) (string, time.Time) {
 	rawString := strLimit(htmlString[startIdx:], 100)
 
 	// Return candidate
-	log.Debug().Msgf("idiosyncratic pattern found: %s", parts[0])
+	opts.log.Debugf("idiosyncratic pattern found: %s", parts[0])
 	return rawString, candidate
 }
 
@@ -369,6 +508,7 @@ func metaImgSearch(doc *html.Node, opts Options) (string, time.Time) {
 		if content != "" {
 			result := extractUrlDate(content, opts)
 			if validateDate(result, opts) {
+				opts.from(MethodMetaImage, elem, "og:image").record(content, result)
 				return content, result
 			}
 		}
@@ -391,7 +531,7 @@ func regexPatternSearch(
 
 	dt := fastParse(parts[1], opts)
 	if validateDate(dt, opts) {
-		log.Debug().Msgf("regex found: %q %q", patternName, parts[0])
+		opts.log.Debugf("regex found: %q %q", patternName, parts[0])
 		return parts[0], dt
 	}
 
@@ -419,7 +559,7 @@ func regexParse(s string, opts Options) time.Time {
 	day, month = trySwapValues(day, month)
 	dt, valid := validateDateParts(year, month, day, opts)
 	if valid {
-		log.Debug().Msgf("multilingual text found: %s", s)
+		opts.log.Debugf("multilingual text found: %s", s)
 		return dt
 	}
 
@@ -438,7 +578,7 @@ func correctYear(year int) int {
 	return year
 }
 
-// trySwapValues swap day and month values if it seems feaaible.
+// trySwapValues swap day and month values if it seems feasible.
 func trySwapValues(day, month int) (int, int) {
 	if month > 12 && day <= 12 {
 		return month, day
@@ -447,11 +587,16 @@ func trySwapValues(day, month int) (int, int) {
 }
 
 type jsonCapturedText struct {
-	Key  string
-	Text string
+	Key        string
+	Text       string
+	Node       *html.Node
+	EntityType string
+	EntityID   string
+	Rank       int
 }
 
 type jsonCapturedDate struct {
 	Text string
 	Date time.Time
+	Rank int
 }
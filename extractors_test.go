@@ -37,7 +37,7 @@ func Test_tryDateExpr(t *testing.T) {
 	// Helper function
 	opts := Options{
 		MinDate: defaultMinDate,
-		MaxDate: defaultMaxDate,
+		MaxDate: testMaxDate,
 	}
 
 	try := func(s string) string {
@@ -74,12 +74,33 @@ func Test_tryDateExpr(t *testing.T) {
 
 	// Mandarin
 	assert.Equal(t, "2022-02-25", try("发布时间: 2022-02-25 14:34"))
+	assert.Equal(t, "2021-07-13", try("发布时间：2021年07月13日 19:25"))
+
+	// Japanese and Korean
+	opts.SkipExtensiveSearch = true
+	assert.Equal(t, "2021-07-13", try("公開日：２０２１年７月１３日"))
+	assert.Equal(t, "2021-07-13", try("입력 2021년 7월 13일 오후 7시 25분"))
+
+	// Roman numeral months
+	assert.Equal(t, "2021-07-13", try("Opublikowano 13.VII.2021"))
+	assert.Equal(t, "2021-07-13", try("2021. VII. 13."))
+
+	// Non-ASCII digits
+	assert.Equal(t, "2021-07-13", try("٢٠٢١-٠٧-١٣"))
+	assert.Equal(t, "2021-07-13", try("۲۰۲۱/۰۷/۱۳"))
+	assert.Equal(t, "2021-07-13", try("१३.०७.२०२१"))
+	assert.Equal(t, "2021-07-13", try("২০২১-০৭-১৩"))
+	assert.Equal(t, "", try("سنة ٢٠٢١"))
+
+	// Original string is kept as source
+	src, _ := tryDateExpr("نشر في ٢٠٢١-٠٧-١٣", opts)
+	assert.Equal(t, "نشر في ٢٠٢١-٠٧-١٣", src)
 }
 
 func Test_fastParse(t *testing.T) {
 	opts := Options{
 		MinDate:   defaultMinDate,
-		MaxDate:   defaultMaxDate,
+		MaxDate:   testMaxDate,
 		EnableLog: true,
 	}
 
@@ -118,12 +139,32 @@ func Test_fastParse(t *testing.T) {
 	assert.Equal(t, "", parse("February 30 2008"))
 	assert.Equal(t, "2008-02-29", parse("XXTag, den 29. Februar 2008"))
 	assert.Equal(t, "", parse("XXTag, den 30. Februar 2008"))
+	// CJK dates
+	assert.Equal(t, "2021-07-13", parse("2021年7月13日"))
+	assert.Equal(t, "2021-07-13", parse("2021年07月13日 19:25"))
+	assert.Equal(t, "2021-07-13", parse("2021년 7월 13일"))
+	assert.Equal(t, "2021-07-13", parse("２０２１年７月１３日"))
+	assert.Equal(t, "2021-07-13", parse("2021年7月13"))
+	assert.Equal(t, "2021-07-01", parse("2021年7月"))
+	assert.Equal(t, "", parse("2021年13月1日"))
+	// Roman numeral months
+	assert.Equal(t, "2021-07-13", parse("13.VII.2021"))
+	assert.Equal(t, "2021-07-13", parse("13 VII 2021"))
+	assert.Equal(t, "2021-07-13", parse("13. VII. 2021 r."))
+	assert.Equal(t, "2021-07-03", parse("3-VII-2021"))
+	assert.Equal(t, "2021-07-13", parse("2021. VII. 13."))
+	assert.Equal(t, "2021-12-01", parse("Warszawa, 1 XII 2021"))
+	assert.Equal(t, "2021-04-09", parse("9.IV.2021"))
+	assert.Equal(t, "", parse("32.VII.2021"))
+	assert.Equal(t, "", parse("13.XIII.2021"))
+	assert.Equal(t, "", parse("13.VIIa.2021"))
+	assert.Equal(t, "", parse("2021年2月30日"))
 }
 
 func Test_regexParse(t *testing.T) {
 	opts := Options{
 		MinDate: defaultMinDate,
-		MaxDate: defaultMaxDate,
+		MaxDate: testMaxDate,
 	}
 
 	parse := func(s string) string {
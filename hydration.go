@@ -0,0 +1,378 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+// DateKeys is the vocabulary of keys that mark the date in script data, e.g. "publishedAt"
+// in `__NEXT_DATA__`. Keys are matched case insensitively while ignoring underscore and
+// dash, so "publishedAt" matches "published_at" and "PUBLISHED-AT" as well.
+type DateKeys struct {
+	// Original is the keys for the original publish date.
+	Original []string
+	// Modified is the keys for the last modified date.
+	Modified []string
+}
+
+// DefaultDateKeys returns the default keys for looking the date in script data.
+func DefaultDateKeys() DateKeys {
+	return DateKeys{
+		Original: []string{
+			"publishedAt", "publishDate", "publishedDate", "publishTime", "publishedTime",
+			"publicationDate", "datePublished", "firstPublished", "firstPublishedAt",
+			"firstPublishedDate", "createdAt", "createdDate", "dateCreated", "displayDate",
+			"pubDate", "releaseDate", "articlePublishDate", "articlePublishedDate",
+			"contentPublishDate", "pagePublishDate",
+		},
+		Modified: []string{
+			"updatedAt", "updatedDate", "updateTime", "modifiedAt", "modifiedDate",
+			"modifiedTime", "dateModified", "lastModified", "lastUpdated",
+			"articleModifiedDate", "articleUpdatedDate",
+		},
+	}
+}
+
+// dateKeySet is the normalized form of `DateKeys`.
+type dateKeySet struct {
+	original map[string]struct{}
+	modified map[string]struct{}
+}
+
+var (
+	defaultDateKeySet = newDateKeySet(nil)
+
+	rxHydrationAssignment = regexp.MustCompile(
+		`(?:\bwindow\.|\bwindow\[["']|\bself\.|\b(?:var|let|const)\s+)(__[A-Z][A-Z0-9_]*__)(?:["']\])?\s*=\s*`)
+)
+
+// newDateKeySet normalizes the key vocabulary. Missing keys are filled with the default.
+func newDateKeySet(keys *DateKeys) *dateKeySet {
+	defaultKeys := DefaultDateKeys()
+	if keys == nil {
+		keys = &defaultKeys
+	}
+
+	original, modified := keys.Original, keys.Modified
+	if original == nil {
+		original = defaultKeys.Original
+	}
+
+	if modified == nil {
+		modified = defaultKeys.Modified
+	}
+
+	set := &dateKeySet{
+		original: make(map[string]struct{}),
+		modified: make(map[string]struct{}),
+	}
+
+	for _, key := range original {
+		set.original[normalizeDateKey(key)] = struct{}{}
+	}
+
+	for _, key := range modified {
+		set.modified[normalizeDateKey(key)] = struct{}{}
+	}
+
+	return set
+}
+
+// dateKeys returns the key vocabulary for looking the date in script data.
+func (opts Options) dateKeys() *dateKeySet {
+	if opts.dateKeySet != nil {
+		return opts.dateKeySet
+	}
+	return defaultDateKeySet
+}
+
+// match checks if the key marks the requested date.
+func (set *dateKeySet) match(key string, original bool) bool {
+	if original {
+		return inMap(normalizeDateKey(key), set.original)
+	}
+	return inMap(normalizeDateKey(key), set.modified)
+}
+
+func normalizeDateKey(key string) string {
+	key = strings.ToLower(strings.TrimSpace(key))
+	key = strings.ReplaceAll(key, "_", "")
+	return strings.ReplaceAll(key, "-", "")
+}
+
+// scriptValue is the value of a date key found in script data.
+type scriptValue struct {
+	key    string
+	text   string
+	node   *html.Node
+	depth  int
+	object int
+}
+
+// hydrationSearch looks for the date in hydration data of JavaScript frameworks, i.e.
+// `<script id="__NEXT_DATA__">` of Next.js and `window.__X__ = {...}` assignments like
+// `__NUXT__`, `__APOLLO_STATE__` and `__INITIAL_STATE__`.
+func hydrationSearch(doc *html.Node, opts Options) (string, time.Time) {
+	var values []scriptValue
+	var nObjects int
+	for _, script := range dom.GetElementsByTagName(doc, "script") {
+		if dom.HasAttribute(script, "src") {
+			continue
+		}
+
+		text := dom.TextContent(script)
+		if dom.ID(script) == "__NEXT_DATA__" {
+			opts.log.Debugf("found hydration data: __NEXT_DATA__")
+			values = append(values, findScriptValues(text, script, &nObjects, opts)...)
+			continue
+		}
+
+		for _, loc := range rxHydrationAssignment.FindAllStringSubmatchIndex(text, -1) {
+			jsonText := hydrationJson(text[loc[1]:])
+			if jsonText != "" {
+				opts.log.Debugf("found hydration data: %s", text[loc[2]:loc[3]])
+				values = append(values, findScriptValues(jsonText, script, &nObjects, opts)...)
+			}
+		}
+	}
+
+	return selectScriptDate(values, MethodHydration, opts)
+}
+
+// hydrationJson returns the JSON text that assigned to the variable, which is either
+// an object literal or string that parsed by `JSON.parse`.
+func hydrationJson(s string) string {
+	if strings.HasPrefix(s, "{") {
+		return matchBracket(s)
+	}
+
+	if strings.HasPrefix(s, "JSON.parse(") {
+		str, ok := unquoteJsString(strings.TrimSpace(s[len("JSON.parse("):]))
+		if ok && strings.HasPrefix(strings.TrimSpace(str), "{") {
+			return str
+		}
+	}
+
+	return ""
+}
+
+// findScriptValues reads the JSON text in order, and returns every value whose key is in
+// the date key vocabulary along with its depth and the object it belongs to.
+func findScriptValues(jsonText string, node *html.Node, nObjects *int, opts Options) []scriptValue {
+	type frame struct {
+		isObject  bool
+		expectKey bool
+		key       string
+		id        int
+	}
+
+	dec := json.NewDecoder(strings.NewReader(jsonText))
+	dec.UseNumber()
+
+	var values []scriptValue
+	var stack []frame
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			if err != io.EOF {
+				opts.log.Debugf("failed to decode script data: %v", err)
+			}
+			break
+		}
+
+		// Handle the start and end of object and array
+		if delim, isDelim := token.(json.Delim); isDelim {
+			switch delim {
+			case '{', '[':
+				*nObjects++
+				stack = append(stack, frame{isObject: delim == '{', expectKey: true, id: *nObjects})
+			default:
+				stack = stack[:max(len(stack)-1, 0)]
+				if len(stack) > 0 && stack[len(stack)-1].isObject {
+					stack[len(stack)-1].expectKey = true
+				}
+			}
+			continue
+		}
+
+		if len(stack) == 0 || !stack[len(stack)-1].isObject {
+			continue
+		}
+
+		// Handle the key and scalar value in object
+		top := &stack[len(stack)-1]
+		if top.expectKey {
+			top.key, _ = token.(string)
+			top.expectKey = false
+			continue
+		}
+
+		top.expectKey = true
+		var text string
+		switch v := token.(type) {
+		case string:
+			text = v
+		case json.Number:
+			text = v.String()
+		default:
+			continue
+		}
+
+		if opts.dateKeys().match(top.key, true) || opts.dateKeys().match(top.key, false) {
+			values = append(values, scriptValue{
+				key:    top.key,
+				text:   normalizeSpaces(text),
+				node:   node,
+				depth:  len(stack),
+				object: top.id,
+			})
+		}
+	}
+
+	return values
+}
+
+// selectScriptDate parses the date values that relevant for the requested date and returns
+// the best one. The shallowest value is preferred since the deeper ones are usually related
+// content, then only the values in the same object are compared.
+func selectScriptDate(values []scriptValue, method Method, opts Options) (string, time.Time) {
+	sort.SliceStable(values, func(a, b int) bool {
+		return values[a].depth < values[b].depth
+	})
+
+	bestObject := -1
+	var bestText string
+	var bestDate time.Time
+	for _, value := range values {
+		if !opts.dateKeys().match(value.key, opts.UseOriginalDate) {
+			continue
+		}
+
+		dt, calendar := parseStructuredValue(value.text, opts)
+		if !validateDate(dt, opts) {
+			continue
+		}
+
+		valueOpts := opts.from(method, value.node, value.key).inCalendar(calendar)
+		if bestObject >= 0 && value.object != bestObject {
+			valueOpts = valueOpts.asReserve()
+		}
+		valueOpts.record(value.text, dt)
+
+		if bestObject < 0 {
+			bestObject = value.object
+		}
+
+		if value.object == bestObject && (bestDate.IsZero() ||
+			(opts.UseOriginalDate && dt.Before(bestDate)) ||
+			(!opts.UseOriginalDate && dt.After(bestDate))) {
+			bestText, bestDate = value.text, dt
+		}
+
+		if !opts.exhaustive() && value.object != bestObject {
+			break
+		}
+	}
+
+	return bestText, bestDate
+}
+
+// matchBracket returns the text from the opening bracket in the start of string until its
+// closing bracket, skipping the brackets inside JavaScript strings.
+func matchBracket(s string) string {
+	var depth int
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			depth--
+			if depth == 0 {
+				return s[:i+1]
+			}
+		}
+	}
+	return ""
+}
+
+// unquoteJsString parses the JavaScript string literal in the start of string.
+func unquoteJsString(s string) (string, bool) {
+	if s == "" || (s[0] != '"' && s[0] != '\'') {
+		return "", false
+	}
+
+	var sb strings.Builder
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == quote:
+			return sb.String(), true
+		case c != '\\':
+			sb.WriteByte(c)
+		case i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case 'u', 'x':
+				size := 4
+				if s[i] == 'x' {
+					size = 2
+				}
+
+				if i+size >= len(s) {
+					return "", false
+				}
+
+				code, err := strconv.ParseUint(s[i+1:i+1+size], 16, 32)
+				if err != nil {
+					return "", false
+				}
+
+				sb.WriteRune(rune(code))
+				i += size
+			default:
+				sb.WriteByte(s[i])
+			}
+		}
+	}
+
+	return "", false
+}
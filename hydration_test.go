@@ -0,0 +1,67 @@
+package htmldate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_hydrationSearch(t *testing.T) {
+	// Helper function
+	check := func(expected string, htmlString string, opts Options) {
+		res := extractFromString(htmlString, opts)
+		assert.Equal(t, expected, res.Format("2006-01-02"), htmlString)
+		assert.Equal(t, MethodHydration, res.Method, htmlString)
+	}
+
+	original := Options{UseOriginalDate: true, SkipExtensiveSearch: true}
+	modified := Options{SkipExtensiveSearch: true}
+
+	// Next.js, where the related articles are nested deeper
+	htmlString := `<html><body><div id="__next"></div>
+	<script id="__NEXT_DATA__" type="application/json">{"props": {"pageProps": {
+		"article": {"title": "Story", "publishedAt": "2021-07-13T10:00:00.000Z", "updatedAt": "2021-07-14T08:00:00.000Z",
+			"related": [{"publishedAt": "2021-07-01"}, {"publishedAt": "2021-06-01"}]},
+		"createdAt": null
+	}}}</script>
+	</body></html>`
+	check("2021-07-13", htmlString, original)
+	check("2021-07-14", htmlString, modified)
+
+	// Assignments to window
+	check("2021-07-13", `<html><body><script>
+		window.__INITIAL_STATE__ = {"page": {"first_published": "2021-07-13", "text": "}{"}};
+	</script></body></html>`, original)
+	check("2021-07-13", `<html><body><script>
+		window["__APOLLO_STATE__"]={"Article:1":{"__typename":"Article","displayDate":"July 13, 2021"}};
+	</script></body></html>`, original)
+	check("2021-07-13", `<html><body><script>
+		window.__NUXT__ = JSON.parse('{"data":{"post":{"published_at":"2021-07-13","title":"It\'s été"}}}');
+	</script></body></html>`, original)
+
+	// Epoch milliseconds
+	check("2021-07-13", `<html><body><script>
+		var __PRELOADED_STATE__ = {"story": {"publishDate": 1626185131000, "lastModified": "1626271531000"}};
+	</script></body></html>`, original)
+	check("2021-07-14", `<html><body><script>
+		var __PRELOADED_STATE__ = {"story": {"publishDate": 1626185131000, "lastModified": "1626271531000"}};
+	</script></body></html>`, modified)
+
+	// Custom key vocabulary
+	htmlString = `<html><body><script>
+		window.__INITIAL_STATE__ = {"story": {"goLiveDate": "2021-07-13", "publishedAt": "2021-07-01"}};
+	</script></body></html>`
+	check("2021-07-01", htmlString, original)
+	check("2021-07-13", htmlString, Options{
+		UseOriginalDate:     true,
+		SkipExtensiveSearch: true,
+		DateKeys:            &DateKeys{Original: []string{"go_live_date"}},
+	})
+
+	// Not hydration data
+	res := extractFromString(`<html><body><script>
+		var config = {"publishedAt": "2021-07-13"};
+		window.__INITIAL_STATE__ = "2021-07-13";
+	</script></body></html>`, original)
+	assert.NotEqual(t, MethodHydration, res.Method)
+}
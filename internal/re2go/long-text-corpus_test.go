@@ -0,0 +1,119 @@
+package re2go_test
+
+import (
+	"strings"
+	"testing"
+
+	htmldate "github.com/markusmobius/go-htmldate"
+	"github.com/markusmobius/go-htmldate/internal/re2go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/html"
+)
+
+// longTextCase is a date with month name, along with whether it's matched by the generated
+// long text pattern.
+type longTextCase struct {
+	language  string
+	text      string
+	expected  string
+	generated bool
+}
+
+// fastDate extracts the date from text of a date element, using fast mode only. The document
+// is parsed as UTF-8 since the encoding of short HTML might be detected wrongly.
+func fastDate(t *testing.T, text, language string) string {
+	opts := htmldate.Options{UseOriginalDate: true, SkipExtensiveSearch: true}
+	if language != "" {
+		opts.Languages = []string{language}
+	}
+
+	str := `<html><body><p class="date">` + text + `</p></body></html>`
+	doc, err := html.Parse(strings.NewReader(str))
+	assert.NoError(t, err)
+
+	res, err := htmldate.FromDocument(doc, opts)
+	assert.NoError(t, err)
+	return res.Format("2006-01-02")
+}
+
+func checkLongTextCorpus(t *testing.T, corpus []longTextCase) {
+	for _, c := range corpus {
+		_, _, _, ok := re2go.FindLongTextPattern(c.text)
+		assert.Equal(t, c.generated, ok, c.text)
+		assert.Equal(t, c.expected, fastDate(t, c.text, c.language), c.text)
+	}
+}
+
+func Test_MonthNameCorpus(t *testing.T) {
+	checkLongTextCorpus(t, []longTextCase{
+		// Languages of the generated pattern
+		{"en", "13 July 2021", "2021-07-13", true},
+		{"fr", "13 juillet 2021", "2021-07-13", true},
+		{"de", "13. Juli 2021", "2021-07-13", true},
+		{"id", "13 Agustus 2021", "2021-08-13", true},
+		{"tr", "13 Temmuz 2021", "2021-07-13", true},
+
+		// Spanish, Italian and Portuguese
+		{"es", "13 de julio de 2021", "2021-07-13", true},
+		{"es", "13 jul. 2021", "2021-07-13", true},
+		{"es", "24 dic. 2021", "2021-12-24", true},
+		{"it", "13 luglio 2021", "2021-07-13", true},
+		{"it", "13 lug 2021", "2021-07-13", true},
+		{"pt", "13 de julho de 2021", "2021-07-13", true},
+		{"pt", "2 de março de 2021", "2021-03-02", true},
+
+		// Dutch, Polish and Czech, including the genitive forms
+		{"nl", "2 maart 2021", "2021-03-02", true},
+		{"nl", "2 mrt. 2021", "2021-03-02", true},
+		{"pl", "13 lipca 2021 r.", "2021-07-13", true},
+		{"pl", "13 lip 2021", "2021-07-13", true},
+		{"cs", "13. července 2021", "2021-07-13", true},
+		{"cs", "24. prosince 2021", "2021-12-24", true},
+
+		// Russian and Ukrainian, including the genitive forms
+		{"ru", "13 июля 2021 года", "2021-07-13", true},
+		{"ru", "2 марта 2021", "2021-03-02", true},
+		{"uk", "13 липня 2021 року", "2021-07-13", true},
+		{"uk", "2 березня 2021", "2021-03-02", true},
+
+		// Full month names are used even if language is unknown
+		{"", "13 de julio de 2021", "2021-07-13", true},
+		{"", "13 июля 2021 года", "2021-07-13", true},
+	})
+}
+
+func Test_VerboseDateCorpus(t *testing.T) {
+	checkLongTextCorpus(t, []longTextCase{
+		// Ordinal day and "of"
+		{"en", "13th of July, 2021", "2021-07-13", true},
+		{"en", "the 1st of July 2021", "2021-07-01", true},
+		{"en", "July the 13th, 2021", "2021-07-13", true},
+		{"fr", "le 1er juillet 2021", "2021-07-01", true},
+		{"de", "am 1. Juli 2021", "2021-07-01", true},
+		{"es", "1º de julio de 2021", "2021-07-01", true},
+		{"it", "1° luglio 2021", "2021-07-01", true},
+		{"pt", "1º de julho de 2021", "2021-07-01", true},
+		{"nl", "13de juli 2021", "2021-07-13", true},
+		{"ru", "13-го июля 2021 года", "2021-07-13", true},
+		{"uk", "13-го липня 2021 року", "2021-07-13", true},
+
+		// Weekday prefix and trailing time
+		{"en", "Tuesday, July 13th, 2021 at 5:30 pm", "2021-07-13", true},
+		{"fr", "mardi 13 juillet 2021 à 17h30", "2021-07-13", true},
+		{"de", "Dienstag, 13. Juli 2021 um 17:30 Uhr", "2021-07-13", true},
+		{"es", "martes, 13 de julio de 2021 a las 17:30", "2021-07-13", true},
+		{"it", "martedì 13 luglio 2021 alle 17:30", "2021-07-13", true},
+		{"pt", "terça-feira, 13 de julho de 2021 às 17h30", "2021-07-13", true},
+		{"nl", "dinsdag 13 juli 2021 om 17:30", "2021-07-13", true},
+		{"pl", "wtorek, 13 lipca 2021 o 17:30", "2021-07-13", true},
+		{"cs", "úterý 13. července 2021 v 17:30", "2021-07-13", true},
+		{"ru", "вторник, 13 июля 2021 г. в 17:30", "2021-07-13", true},
+		{"hu", "2021. július 13., kedd 17:30", "2021-07-13", true},
+		{"tr", "13 Temmuz 2021 Salı 17:30", "2021-07-13", true},
+		{"id", "Selasa, 13 Juli 2021 pukul 17.30", "2021-07-13", true},
+
+		// Full month names are used even if language is unknown
+		{"", "le 1er juillet 2021", "2021-07-01", true},
+		{"", "13th of July, 2021", "2021-07-13", true},
+	})
+}
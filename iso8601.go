@@ -0,0 +1,298 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"strings"
+	"time"
+)
+
+// parseISO8601 parses the string which entirely written in ISO 8601 format, e.g. the
+// value of <meta> or JSON-LD. Beside the calendar date in extended (2021-07-13) and
+// basic (20210713) format, it also handles week date (2021-W28-2), ordinal date
+// (2021-194), and the optional time with fractional seconds and UTC offset. Since it's
+// used for every structured value, it's written as simple scanner instead of regex.
+// Returns false if the string is not a valid ISO 8601 date.
+func parseISO8601(s string) (date time.Time, hasTime bool, hasOffset bool, ok bool) {
+	s = strings.TrimSpace(s)
+	sc := isoScanner{s: s}
+
+	year, month, day, ok := sc.date()
+	if !ok {
+		return
+	}
+
+	// Parse the time, which separated by "T" or space as allowed in RFC 3339
+	var hour, minute, second, nanosecond int
+	if sc.consume('T') || sc.consume('t') || (sc.isDigitAt(sc.i+1) && sc.consume(' ')) {
+		if hour, minute, second, nanosecond, ok = sc.time(); !ok {
+			return
+		}
+		hasTime = true
+	}
+
+	// Parse the UTC offset, which only allowed after time
+	loc := time.UTC
+	if hasTime && !sc.done() {
+		if loc, ok = sc.offset(); !ok {
+			return
+		}
+		hasOffset = true
+	}
+
+	if !sc.done() {
+		return timeZero, false, false, false
+	}
+
+	// Midnight at the end of the day is written as 24:00
+	endOfDay := hour == 24
+	if endOfDay {
+		if minute != 0 || second != 0 || nanosecond != 0 {
+			return timeZero, false, false, false
+		}
+		hour = 0
+	}
+
+	date = time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, loc)
+	if endOfDay {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return date, hasTime, hasOffset, true
+}
+
+// isoDate returns the date part of ISO 8601 string, in the same form as the date that
+// returned by the other parsers.
+func isoDate(s string) (time.Time, bool) {
+	dt, _, _, ok := parseISO8601(s)
+	if !ok {
+		return timeZero, false
+	}
+	return time.Date(dt.Year(), dt.Month(), dt.Day(), 0, 0, 0, 0, time.UTC), true
+}
+
+// isoScanner reads the ISO 8601 string byte by byte.
+type isoScanner struct {
+	s string
+	i int
+}
+
+// date reads the date part, which could be written as calendar, week or ordinal date.
+func (sc *isoScanner) date() (year, month, day int, ok bool) {
+	if year, ok = sc.number(4); !ok {
+		return
+	}
+
+	// Week date: YYYY-Www-D or YYYYWwwD, where the weekday defaults to Monday
+	extended := sc.consume('-')
+	if sc.consume('W') {
+		var week int
+		if week, ok = sc.number(2); !ok {
+			return
+		}
+
+		weekday := 1
+		if (extended && sc.consume('-')) || (!extended && sc.isDigitAt(sc.i)) {
+			if weekday, ok = sc.number(1); !ok {
+				return
+			}
+		}
+
+		return isoWeekDate(year, week, weekday)
+	}
+
+	// Ordinal date: YYYY-DDD or YYYYDDD
+	if sc.digitsAhead() == 3 {
+		var yearDay int
+		if yearDay, ok = sc.number(3); !ok {
+			return
+		}
+		return isoOrdinalDate(year, yearDay)
+	}
+
+	// Calendar date: YYYY-MM-DD or YYYYMMDD
+	if month, ok = sc.number(2); !ok {
+		return
+	}
+
+	if extended && !sc.consume('-') {
+		return 0, 0, 0, false
+	}
+
+	if day, ok = sc.number(2); !ok {
+		return
+	}
+
+	if month < 1 || month > 12 || day < 1 || day > daysInMonth(year, month) {
+		return 0, 0, 0, false
+	}
+
+	return year, month, day, true
+}
+
+// time reads the time part, i.e. hh:mm:ss.sss or hhmmss.sss where minute, second and
+// the fraction are optional.
+func (sc *isoScanner) time() (hour, minute, second, nanosecond int, ok bool) {
+	if hour, ok = sc.number(2); !ok || hour > 24 {
+		return 0, 0, 0, 0, false
+	}
+
+	extended := sc.consume(':')
+	if !extended && !sc.isDigitAt(sc.i) {
+		return hour, 0, 0, 0, true
+	}
+
+	if minute, ok = sc.number(2); !ok || minute > 59 {
+		return 0, 0, 0, 0, false
+	}
+
+	if (extended && sc.consume(':')) || (!extended && sc.isDigitAt(sc.i)) {
+		if second, ok = sc.number(2); !ok || second > 59 {
+			return 0, 0, 0, 0, false
+		}
+
+		// Fraction is separated by either dot or comma. Digits beyond nanosecond are ignored.
+		if sc.consume('.') || sc.consume(',') {
+			nDigits := sc.digitsAhead()
+			if nDigits == 0 {
+				return 0, 0, 0, 0, false
+			}
+
+			for j := 0; j < 9; j++ {
+				nanosecond *= 10
+				if j < nDigits {
+					nanosecond += int(sc.s[sc.i+j] - '0')
+				}
+			}
+			sc.i += nDigits
+		}
+	}
+
+	return hour, minute, second, nanosecond, true
+}
+
+// offset reads the UTC offset, i.e. Z, ±hh, ±hh:mm or ±hhmm. Space before the offset
+// is tolerated since it's common in the wild.
+func (sc *isoScanner) offset() (*time.Location, bool) {
+	if sc.consume('Z') || sc.consume('z') {
+		return time.UTC, true
+	}
+
+	sc.consume(' ')
+	start := sc.i
+	sign := 1
+	switch {
+	case sc.consume('+'):
+	case sc.consume('-'):
+		sign = -1
+	default:
+		return nil, false
+	}
+
+	hour, ok := sc.number(2)
+	if !ok || hour > 23 {
+		return nil, false
+	}
+
+	var minute int
+	if sc.consume(':') || sc.isDigitAt(sc.i) {
+		if minute, ok = sc.number(2); !ok || minute > 59 {
+			return nil, false
+		}
+	}
+
+	return time.FixedZone(sc.s[start:sc.i], sign*(hour*3_600+minute*60)), true
+}
+
+// number reads exactly n digits as a number.
+func (sc *isoScanner) number(n int) (int, bool) {
+	if sc.i+n > len(sc.s) {
+		return 0, false
+	}
+
+	var value int
+	for j := 0; j < n; j++ {
+		c := sc.s[sc.i+j]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		value = value*10 + int(c-'0')
+	}
+
+	sc.i += n
+	return value, true
+}
+
+// digitsAhead counts the consecutive digits from the current position.
+func (sc *isoScanner) digitsAhead() int {
+	var n int
+	for sc.isDigitAt(sc.i + n) {
+		n++
+	}
+	return n
+}
+
+func (sc *isoScanner) isDigitAt(i int) bool {
+	return i < len(sc.s) && sc.s[i] >= '0' && sc.s[i] <= '9'
+}
+
+func (sc *isoScanner) peek(c byte) bool {
+	return sc.i < len(sc.s) && sc.s[sc.i] == c
+}
+
+func (sc *isoScanner) consume(c byte) bool {
+	if sc.peek(c) {
+		sc.i++
+		return true
+	}
+	return false
+}
+
+func (sc *isoScanner) done() bool {
+	return sc.i == len(sc.s)
+}
+
+// isoWeekDate converts the ISO week date into calendar date. The first week of the
+// year is the one that contains 4 January.
+func isoWeekDate(year, week, weekday int) (int, int, int, bool) {
+	_, nWeeks := time.Date(year, 12, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	if week < 1 || week > nWeeks || weekday < 1 || weekday > 7 {
+		return 0, 0, 0, false
+	}
+
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan4.Weekday())+6)%7 - (week-1)*7 - (weekday - 1)
+	date := jan4.AddDate(0, 0, -offset)
+	return date.Year(), int(date.Month()), date.Day(), true
+}
+
+// isoOrdinalDate converts the ISO ordinal date into calendar date.
+func isoOrdinalDate(year, yearDay int) (int, int, int, bool) {
+	nDays := 365
+	if daysInMonth(year, 2) == 29 {
+		nDays = 366
+	}
+
+	if yearDay < 1 || yearDay > nDays {
+		return 0, 0, 0, false
+	}
+
+	date := time.Date(year, 1, yearDay, 0, 0, 0, 0, time.UTC)
+	return date.Year(), int(date.Month()), date.Day(), true
+}
+
+func daysInMonth(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,103 @@
+package htmldate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseISO8601(t *testing.T) {
+	// Helper function
+	check := func(expected string, expectedTime, expectedOffset bool, s string) {
+		dt, hasTime, hasOffset, ok := parseISO8601(s)
+		assert.Equal(t, expected != "", ok, s)
+		if ok {
+			assert.Equal(t, expected, dt.Format("2006-01-02T15:04:05.999999999Z07:00"), s)
+			assert.Equal(t, expectedTime, hasTime, s)
+			assert.Equal(t, expectedOffset, hasOffset, s)
+		}
+	}
+
+	// Calendar date
+	check("2021-07-13T00:00:00Z", false, false, "2021-07-13")
+	check("2021-07-13T00:00:00Z", false, false, "20210713")
+	check("2020-02-29T00:00:00Z", false, false, " 2020-02-29 ")
+
+	// Week date
+	check("2021-07-13T00:00:00Z", false, false, "2021-W28-2")
+	check("2021-07-13T00:00:00Z", false, false, "2021W282")
+	check("2021-07-12T00:00:00Z", false, false, "2021-W28")
+	check("2021-01-04T00:00:00Z", false, false, "2021-W01-1")
+	check("2020-12-31T00:00:00Z", false, false, "2020-W53-4")
+	check("2019-12-30T00:00:00Z", false, false, "2020-W01-1")
+
+	// Ordinal date
+	check("2021-07-13T00:00:00Z", false, false, "2021-194")
+	check("2021-07-13T00:00:00Z", false, false, "2021194")
+	check("2020-12-31T00:00:00Z", false, false, "2020-366")
+
+	// Date with time and offset
+	check("2021-07-13T19:25:31Z", true, true, "20210713T192531Z")
+	check("2021-07-13T19:25:31Z", true, true, "2021-07-13T19:25:31Z")
+	check("2021-07-13T19:25:00+02:00", true, true, "2021-07-13T19:25+02:00")
+	check("2021-07-13T19:25:31.123456+05:30", true, true, "2021-07-13T19:25:31.123456+05:30")
+	check("2021-07-13T19:25:31.5-04:00", true, true, "2021-07-13T19:25:31,5-0400")
+	check("2021-07-13T19:25:31.123456789+09:00", true, true, "2021-07-13T19:25:31.1234567891+09")
+	check("2021-07-13T19:25:31+02:00", true, true, "2021-07-13 19:25:31 +0200")
+	check("2021-07-13T19:25:31Z", true, false, "2021-07-13T19:25:31")
+	check("2021-07-13T19:00:00Z", true, false, "2021-W28-2T19")
+	check("2021-07-14T00:00:00Z", true, false, "2021-07-13T24:00")
+
+	// Invalid or not ISO 8601
+	check("", false, false, "2021-07")
+	check("", false, false, "2021-13-01")
+	check("", false, false, "2021-02-29")
+	check("", false, false, "2021-366")
+	check("", false, false, "2021-W53-1")
+	check("", false, false, "2021-W28-8")
+	check("", false, false, "2021-07-13T25:00")
+	check("", false, false, "2021-07-13T19:25:31.")
+	check("", false, false, "2021-07-13T19:25:31+24:00")
+	check("", false, false, "2021-07-13+02:00")
+	check("", false, false, "2021-07-13T")
+	check("", false, false, "13/07/2021")
+	check("", false, false, "July 13, 2021")
+	check("", false, false, "2021-07-13 by John")
+}
+
+func Test_ISO8601(t *testing.T) {
+	opts := Options{ExtractTime: true}
+
+	// Helper function
+	check := func(expected string, htmlString string) {
+		result, err := FromReader(strings.NewReader(htmlString), opts)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result.Format(time.RFC3339Nano), htmlString)
+	}
+
+	check("2021-07-13T00:00:00Z", `<html><head>
+		<meta property="article:modified_time" content="2021-W28-2"/>
+	</head></html>`)
+	check("2021-07-13T00:00:00Z", `<html><head>
+		<meta name="date" content="2021-194"/>
+	</head></html>`)
+	check("2021-07-13T19:25:31Z", `<html><head>
+		<meta name="last-modified" content="20210713T192531Z"/>
+	</head></html>`)
+	check("2021-07-13T19:25:31.25+05:30", `<html><head>
+		<meta name="date" content="2021-07-13T19:25:31.25+05:30"/>
+	</head></html>`)
+	check("2021-07-13T19:25:31-04:00", `<html><head>
+		<script type="application/ld+json">{"dateModified": "2021-07-13T19:25:31.000-04:00"}</script>
+	</head></html>`)
+	check("2021-07-13T00:00:00Z", `<html><head>
+		<script type="application/ld+json">{"dateModified": "2021-W28-2"}</script>
+	</head></html>`)
+
+	// Local date is kept, even if it's different day in UTC
+	check("2021-07-13T23:30:00-05:00", `<html><head>
+		<meta name="date" content="2021-07-13T23:30:00-05:00"/>
+	</head></html>`)
+}
@@ -0,0 +1,340 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+var (
+	// rxJsObjectStart matches object literal that pushed or assigned in script, e.g.
+	// `dataLayer.push({` or `utag_data = {`. The first submatch is name of the target.
+	rxJsObjectStart = regexp.MustCompile(`([\w$]+)(?:["']\])?(?:\.push\(\s*|\s*=\s*)\{`)
+
+	// analyticsTargets is the normalized names of objects used by analytics snippets, e.g.
+	// Google Tag Manager, Tealium, Adobe Analytics, Chartbeat and Parse.ly.
+	analyticsTargets = sliceToMap("datalayer", "utagdata", "digitaldata", "sfasyncconfig",
+		"parsely", "parselypage")
+
+	// rxJsPropertyAssignment matches string assigned to object property, e.g.
+	// `digitalData.page.publishDate = '2021-07-13'`.
+	rxJsPropertyAssignment = regexp.MustCompile(`[\w$\]]\.([\w$]+)\s*=\s*(?:"([^"\\\n]*)"|'([^'\\\n]*)')`)
+)
+
+// analyticsSearch looks for the date in JavaScript object literals of inline scripts,
+// which mostly used by analytics snippets like `dataLayer.push({...})`, `utag_data = {...}`
+// and Parse.ly config. Since they are not valid JSON, a tolerant scanner is used instead
+// of JSON decoder.
+func analyticsSearch(doc *html.Node, opts Options) (string, time.Time) {
+	var values []scriptValue
+	var nObjects int
+
+	// Parse.ly metadata is written as object in <meta>
+	for _, meta := range dom.QuerySelectorAll(doc, `meta[name="parsely-page"]`) {
+		content := dom.GetAttribute(meta, "content")
+		values = append(values, findLiteralValues(content, meta, &nObjects, opts)...)
+	}
+
+	for _, script := range dom.GetElementsByTagName(doc, "script") {
+		scriptType := strings.ToLower(strings.TrimSpace(dom.GetAttribute(script, "type")))
+		if dom.HasAttribute(script, "src") || strings.Contains(scriptType, "json") {
+			continue
+		}
+
+		text := dom.TextContent(script)
+		for _, loc := range rxJsObjectStart.FindAllStringSubmatchIndex(text, -1) {
+			if isAnalyticsTarget(text[loc[2]:loc[3]], opts) {
+				values = append(values, findLiteralValues(text[loc[1]-1:], script, &nObjects, opts)...)
+			}
+		}
+
+		for _, parts := range rxJsPropertyAssignment.FindAllStringSubmatch(text, -1) {
+			key := parts[1]
+			if opts.dateKeys().match(key, true) || opts.dateKeys().match(key, false) {
+				nObjects++
+				values = append(values, scriptValue{
+					key:    key,
+					text:   normalizeSpaces(parts[2] + parts[3]),
+					node:   script,
+					depth:  1,
+					object: nObjects,
+				})
+			}
+		}
+	}
+
+	return selectScriptDate(values, MethodAnalytics, opts)
+}
+
+// isAnalyticsTarget checks if the object pushed or assigned to the target is used by
+// analytics snippet, either the known one or named by the date key vocabulary. Other
+// objects like comment or widget configs are ignored, since their dates might belong
+// to something other than the page.
+func isAnalyticsTarget(name string, opts Options) bool {
+	return inMap(normalizeDateKey(name), analyticsTargets) ||
+		opts.dateKeys().match(name, true) || opts.dateKeys().match(name, false)
+}
+
+// findLiteralValues scans the object literal in the start of string, and returns every
+// value whose key is in the date key vocabulary along with its depth and the object it
+// belongs to.
+func findLiteralValues(s string, node *html.Node, nObjects *int, opts Options) []scriptValue {
+	sc := jsScanner{s: s, node: node, nObjects: nObjects, opts: opts}
+	sc.skipSpace()
+	if sc.peek() == '{' {
+		sc.value("", 0, 0)
+	}
+	return sc.values
+}
+
+// jsScanner is a tolerant scanner for JavaScript object literal, which accepts single
+// quoted string, unquoted keys, trailing commas and comments. Values that are not
+// literal (e.g. function call) are skipped.
+type jsScanner struct {
+	s        string
+	i        int
+	node     *html.Node
+	nObjects *int
+	opts     Options
+	values   []scriptValue
+}
+
+// value scans a single value of the key in an object.
+func (sc *jsScanner) value(key string, depth, object int) {
+	sc.skipSpace()
+	switch c := sc.peek(); {
+	case c == '{':
+		sc.object(depth + 1)
+	case c == '[':
+		sc.array(key, depth+1)
+	case c == '"' || c == '\'' || c == '`':
+		str, ok := sc.str()
+		if ok {
+			sc.emit(key, str, depth, object)
+		}
+		sc.skipExpression()
+	default:
+		start := sc.i
+		sc.skipExpression()
+		if raw := strings.TrimSpace(sc.s[start:sc.i]); raw != "" && isDigit(raw) {
+			sc.emit(key, raw, depth, object)
+		}
+	}
+}
+
+// object scans object literal, i.e. `{key: value, ...}`.
+func (sc *jsScanner) object(depth int) {
+	*sc.nObjects++
+	id := *sc.nObjects
+	sc.i++
+
+	for !sc.done() {
+		sc.skipSpace()
+		switch sc.peek() {
+		case '}':
+			sc.i++
+			return
+		case ',':
+			sc.i++
+			continue
+		}
+
+		// Key might be quoted, unquoted or computed
+		var key string
+		switch c := sc.peek(); {
+		case c == '"' || c == '\'' || c == '`':
+			key, _ = sc.str()
+		case c == '[':
+			sc.skipExpression()
+		default:
+			start := sc.i
+			for !sc.done() && isJsIdentifier(sc.peek()) {
+				sc.i++
+			}
+			key = sc.s[start:sc.i]
+		}
+
+		// Shorthand property or method doesn't have colon
+		sc.skipSpace()
+		if sc.peek() != ':' {
+			if sc.i == len(sc.s) || !strings.ContainsRune(",}", rune(sc.peek())) {
+				sc.skipExpression()
+			}
+			if !strings.ContainsRune(",}", rune(sc.peek())) {
+				return
+			}
+			continue
+		}
+
+		sc.i++
+		sc.value(key, depth, id)
+	}
+}
+
+// array scans array literal, i.e. `[value, ...]`.
+func (sc *jsScanner) array(key string, depth int) {
+	*sc.nObjects++
+	id := *sc.nObjects
+	sc.i++
+
+	for !sc.done() {
+		sc.skipSpace()
+		switch sc.peek() {
+		case ']':
+			sc.i++
+			return
+		case ',':
+			sc.i++
+			continue
+		}
+
+		before := sc.i
+		sc.value(key, depth, id)
+		if sc.i == before {
+			return
+		}
+	}
+}
+
+// emit saves the value if its key is in the date key vocabulary.
+func (sc *jsScanner) emit(key, text string, depth, object int) {
+	if !sc.opts.dateKeys().match(key, true) && !sc.opts.dateKeys().match(key, false) {
+		return
+	}
+
+	sc.values = append(sc.values, scriptValue{
+		key:    key,
+		text:   normalizeSpaces(text),
+		node:   sc.node,
+		depth:  depth,
+		object: object,
+	})
+}
+
+// str scans string literal. Template literal is returned as it is.
+func (sc *jsScanner) str() (string, bool) {
+	quote := sc.peek()
+	if quote == '`' {
+		start := sc.i
+		sc.skipString()
+		return strings.Trim(sc.s[start:sc.i], "`"), true
+	}
+
+	str, ok := unquoteJsString(sc.s[sc.i:])
+	sc.skipString()
+	return str, ok
+}
+
+// skipString moves the position after the string literal in the current position.
+func (sc *jsScanner) skipString() {
+	quote := sc.peek()
+	for sc.i++; !sc.done(); sc.i++ {
+		switch sc.s[sc.i] {
+		case '\\':
+			sc.i++
+		case quote:
+			sc.i++
+			return
+		}
+	}
+}
+
+// skipExpression moves the position to the end of the current expression, i.e. the
+// comma or closing bracket that not nested inside the expression.
+func (sc *jsScanner) skipExpression() {
+	var depth int
+	for !sc.done() {
+		switch c := sc.peek(); c {
+		case '"', '\'', '`':
+			sc.skipString()
+			continue
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth == 0 {
+				return
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				return
+			}
+		case '/':
+			if sc.skipComment() {
+				continue
+			}
+		}
+		sc.i++
+	}
+}
+
+// skipSpace moves the position after whitespaces and comments.
+func (sc *jsScanner) skipSpace() {
+	for !sc.done() {
+		switch sc.peek() {
+		case ' ', '\t', '\n', '\r':
+			sc.i++
+		case '/':
+			if !sc.skipComment() {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+// skipComment moves the position after the comment, if any.
+func (sc *jsScanner) skipComment() bool {
+	rest := sc.s[sc.i:]
+	switch {
+	case strings.HasPrefix(rest, "//"):
+		if idx := strings.IndexByte(rest, '\n'); idx >= 0 {
+			sc.i += idx + 1
+		} else {
+			sc.i = len(sc.s)
+		}
+		return true
+	case strings.HasPrefix(rest, "/*"):
+		if idx := strings.Index(rest[2:], "*/"); idx >= 0 {
+			sc.i += idx + 4
+		} else {
+			sc.i = len(sc.s)
+		}
+		return true
+	}
+	return false
+}
+
+func (sc *jsScanner) peek() byte {
+	if sc.done() {
+		return 0
+	}
+	return sc.s[sc.i]
+}
+
+func (sc *jsScanner) done() bool {
+	return sc.i >= len(sc.s)
+}
+
+func isJsIdentifier(c byte) bool {
+	return c == '_' || c == '$' || (c >= '0' && c <= '9') ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
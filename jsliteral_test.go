@@ -0,0 +1,129 @@
+package htmldate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_findLiteralValues(t *testing.T) {
+	// Helper function
+	check := func(literal string, expected ...string) {
+		var nObjects int
+		var texts []string
+		for _, value := range findLiteralValues(literal, nil, &nObjects, Options{}) {
+			texts = append(texts, value.key+"="+value.text)
+		}
+		assert.Equal(t, expected, texts, literal)
+	}
+
+	check(`{'publishDate':'2021-07-13'}`, "publishDate=2021-07-13")
+	check(`{publishDate: "2021-07-13", modifiedDate: '2021-07-14',}`,
+		"publishDate=2021-07-13", "modifiedDate=2021-07-14")
+	check(`{"page": {pub_date: '2021-07-13', title: 'It\'s }{'}, 'lastUpdated': 1626271531000}`,
+		"pub_date=2021-07-13", "lastUpdated=1626271531000")
+	check(`{
+		// comment with publishDate: '2020-01-01'
+		callback: function() { return {publishDate: 'x'} },
+		sections: ['News', 'World',],
+		/* comment */ 'publish_date': '2021-07-13',
+		shorthand, method() {},
+		[computed]: 'value',
+		"release-date": `+"`2021-07-13`"+`
+	}`, "publish_date=2021-07-13", "release-date=2021-07-13")
+
+	// Unfinished or not an object
+	check(`{publishDate: '2021-07-13', modifiedDate: `, "publishDate=2021-07-13")
+	check(`[{publishDate: '2021-07-13'}]`)
+	check(``)
+}
+
+func Test_analyticsSearch(t *testing.T) {
+	// Helper function
+	check := func(expected string, htmlString string, opts Options) {
+		res := extractFromString(htmlString, opts)
+		assert.Equal(t, expected, res.Format("2006-01-02"), htmlString)
+		assert.Equal(t, MethodAnalytics, res.Method, htmlString)
+	}
+
+	original := Options{UseOriginalDate: true, SkipExtensiveSearch: true}
+	modified := Options{SkipExtensiveSearch: true}
+
+	// Google Tag Manager
+	htmlString := `<html><body><script>
+		window.dataLayer = window.dataLayer || [];
+		dataLayer.push({'event': 'pageview', 'publishDate':'2021-07-13', 'modifiedDate': '2021-07-14',});
+	</script></body></html>`
+	check("2021-07-13", htmlString, original)
+	check("2021-07-14", htmlString, modified)
+
+	// Tealium and Adobe Analytics
+	check("2021-07-13", `<html><body><script type="text/javascript">
+		var utag_data = {
+			page_type: "article",
+			article_publish_date: "13.07.2021",
+			related: [{article_publish_date: "01.07.2021"}],
+		};
+	</script></body></html>`, original)
+	check("2021-07-13", `<html><body><script>
+		digitalData.page.pageInfo.publishDate = "2021-07-13T10:00:00Z";
+		s.prop5 = "2021-07-01";
+	</script></body></html>`, original)
+
+	// Parse.ly
+	check("2021-07-13", `<html><head>
+		<meta name="parsely-page" content='{"title": "Story", "pub_date": "2021-07-13T10:00:00Z"}'>
+	</head><body></body></html>`, original)
+
+	// Epoch milliseconds
+	check("2021-07-13", `<html><body><script>
+		_sf_async_config = {uid: 12345, publishDate: 1626185131000};
+	</script></body></html>`, original)
+
+	// Custom key vocabulary
+	check("2021-07-13", `<html><body><script>
+		dataLayer.push({goLiveDate: '2021-07-13'});
+	</script></body></html>`, Options{
+		UseOriginalDate:     true,
+		SkipExtensiveSearch: true,
+		DateKeys:            &DateKeys{Original: []string{"go_live_date"}},
+	})
+
+	// External and JSON scripts are skipped
+	res := extractFromString(`<html><body>
+		<script src="analytics.js">dataLayer.push({publishDate: '2021-07-13'});</script>
+		<script type="application/json">{"config": {"publishDate": "2021-07-13"}}</script>
+		<script>var x = {title: '2021-07-13'};</script>
+	</body></html>`, original)
+	assert.NotEqual(t, MethodAnalytics, res.Method)
+
+	// Target assigned with brackets, or named by the key vocabulary
+	check("2021-07-13", `<html><body><script>
+		window['utag_data'] = {article_publish_date: '2021-07-13'};
+	</script></body></html>`, original)
+	check("2021-07-13", `<html><body><script>
+		window.pageInfo = {publishDate: '2021-06-01'};
+		window.publishDate = {publishDate: '2021-07-13'};
+	</script></body></html>`, original)
+
+	// Objects of comments and widgets are not analytics
+	res = extractFromString(`<html><body>
+		<script>
+			var comment = {author: 'Reader', publishDate: '2021-07-01'};
+			window.relatedConfig = {items: [{publishDate: '2021-06-01'}]};
+		</script>
+		<time datetime="2021-07-13">13 July</time>
+	</body></html>`, original)
+	assert.Equal(t, "2021-07-13", res.Format("2006-01-02"))
+	assert.Equal(t, MethodTime, res.Method)
+}
+
+func Test_isAnalyticsTarget(t *testing.T) {
+	for _, name := range []string{"dataLayer", "utag_data", "digitalData", "_sf_async_config", "PARSELY", "publishDate"} {
+		assert.True(t, isAnalyticsTarget(name, Options{}), name)
+	}
+
+	for _, name := range []string{"comment", "relatedConfig", "widget", "pageInfo"} {
+		assert.False(t, isAnalyticsTarget(name, Options{}), name)
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	nurl "net/url"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Rank of JSON-LD entities, i.e. how likely their dates belong to the page itself.
+const (
+	jsonRankExcluded    = iota // entity whose date never belongs to the page, e.g. comment
+	jsonRankNested             // entity nested inside another, e.g. related article teaser
+	jsonRankOther              // top level entity with other or no type
+	jsonRankPage               // top level web page
+	jsonRankArticle            // top level article
+	jsonRankMatchedPage        // web page whose URL matches the page URL
+	jsonRankMatched            // article whose URL matches the page URL
+)
+
+var (
+	// jsonArticleTypes is the schema.org types for the main article of a page.
+	jsonArticleTypes = sliceToMap("Article", "NewsArticle", "BlogPosting", "LiveBlogPosting",
+		"ReportageNewsArticle", "AnalysisNewsArticle", "OpinionNewsArticle", "ReviewNewsArticle",
+		"BackgroundNewsArticle", "AskPublicNewsArticle", "SatiricalArticle", "ScholarlyArticle",
+		"TechArticle", "Report", "SocialMediaPosting", "DiscussionForumPosting")
+
+	// jsonPageTypes is the schema.org types for the web page itself.
+	jsonPageTypes = sliceToMap("WebPage", "ItemPage", "AboutPage", "CollectionPage",
+		"FAQPage", "QAPage", "MedicalWebPage")
+
+	// schemaExcludedTypes is the schema.org types whose dates never belong to the page, so
+	// they and everything nested inside them are ignored.
+	schemaExcludedTypes = sliceToMap("Comment", "Answer", "Review", "CriticReview", "UserReview",
+		"EmployerReview", "Rating", "AggregateRating", "BreadcrumbList", "ListItem", "ItemList",
+		"Organization", "NewsMediaOrganization", "Corporation", "Person", "WebSite",
+		"ImageObject", "Brand", "Offer")
+)
+
+// jsonLdEntity is a JSON object in JSON-LD script that describes a thing.
+type jsonLdEntity struct {
+	data     map[string]interface{}
+	node     *html.Node
+	types    []string
+	id       string
+	nested   bool
+	excluded bool
+	mainOf   *jsonLdEntity
+}
+
+// jsonLdGraph is the entities from every JSON-LD scripts in the document, which could
+// refer each other using their @id.
+type jsonLdGraph struct {
+	entities []*jsonLdEntity
+	ids      map[string]*jsonLdEntity
+	baseURL  string
+	pageURL  string
+}
+
+func newJsonLdGraph(pageURL string) *jsonLdGraph {
+	return &jsonLdGraph{
+		ids:     make(map[string]*jsonLdEntity),
+		baseURL: pageURL,
+		pageURL: normalizeEntityURL(pageURL, pageURL),
+	}
+}
+
+// add collects the object and the entities nested inside it. Members of @graph and
+// the main entity of a page are treated as top level entities.
+func (g *jsonLdGraph) add(obj map[string]interface{}, node *html.Node, parent *jsonLdEntity, isMain bool) {
+	// Object that only refers other entity doesn't have anything to collect
+	if _, isRef := obj["@id"]; isRef && len(obj) == 1 {
+		return
+	}
+
+	entity := &jsonLdEntity{
+		data:   obj,
+		node:   node,
+		types:  entityTypes(obj["@type"]),
+		id:     jsonString(obj["@id"]),
+		nested: parent != nil && !isMain,
+	}
+
+	if parent != nil {
+		entity.excluded = parent.excluded
+		if isMain {
+			entity.mainOf = parent
+		}
+	}
+
+	for _, t := range entity.types {
+		if _, exist := schemaExcludedTypes[t]; exist {
+			entity.excluded = true
+		}
+	}
+
+	g.entities = append(g.entities, entity)
+	if entity.id != "" {
+		if _, exist := g.ids[entity.id]; !exist {
+			g.ids[entity.id] = entity
+		}
+	}
+
+	for _, key := range jsonLdKeys(obj) {
+		switch v := obj[key].(type) {
+		case map[string]interface{}:
+			g.add(v, node, entity, key == "mainEntity")
+
+		case []interface{}:
+			for _, item := range v {
+				if itemObject, isObject := item.(map[string]interface{}); isObject {
+					if key == "@graph" {
+						g.add(itemObject, node, parent, isMain)
+					} else {
+						g.add(itemObject, node, entity, key == "mainEntity")
+					}
+				}
+			}
+		}
+	}
+}
+
+// rank returns how likely the dates of the entity belong to the page itself.
+func (g *jsonLdGraph) rank(e *jsonLdEntity) int {
+	isArticle := hasEntityType(e, jsonArticleTypes)
+	isPage := hasEntityType(e, jsonPageTypes)
+
+	switch {
+	case e.excluded:
+		return jsonRankExcluded
+	case isArticle && g.matchesPage(e):
+		return jsonRankMatched
+	case isPage && g.matchesPage(e):
+		return jsonRankMatchedPage
+	case e.nested:
+		return jsonRankNested
+	case isArticle:
+		return jsonRankArticle
+	case isPage:
+		return jsonRankPage
+	default:
+		return jsonRankOther
+	}
+}
+
+// matchesPage checks if the entity describes the page, i.e. its URL, @id or main entity
+// of page matches the page URL, or it's the main entity of a matching web page.
+func (g *jsonLdGraph) matchesPage(e *jsonLdEntity) bool {
+	if g.pageURL == "" {
+		return false
+	}
+
+	if g.describesPage(e) || (e.mainOf != nil && g.describesPage(e.mainOf)) {
+		return true
+	}
+
+	// Look for web page that refers this entity as its main entity
+	if e.id == "" {
+		return false
+	}
+
+	for _, other := range g.entities {
+		if ref, isRef := other.data["mainEntity"].(map[string]interface{}); isRef {
+			if jsonString(ref["@id"]) == e.id && g.describesPage(other) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// describesPage checks if the URL, @id or main entity of page of the entity itself
+// matches the page URL.
+func (g *jsonLdGraph) describesPage(e *jsonLdEntity) bool {
+	if g.matchesURL(e.id) || g.matchesURL(e.data["url"]) {
+		return true
+	}
+
+	switch v := e.data["mainEntityOfPage"].(type) {
+	case string:
+		return g.matchesURL(v)
+	case map[string]interface{}:
+		if g.matchesURL(v["@id"]) || g.matchesURL(v["url"]) {
+			return true
+		}
+
+		// Main entity of page might be a reference to the web page
+		if ref, exist := g.ids[jsonString(v["@id"])]; exist && ref != e {
+			return g.matchesURL(ref.data["url"])
+		}
+	}
+
+	return false
+}
+
+// matchesURL checks if the JSON value is URL (or list of URLs) of the page.
+func (g *jsonLdGraph) matchesURL(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v != "" && normalizeEntityURL(v, g.baseURL) == g.pageURL
+	case []interface{}:
+		for _, item := range v {
+			if g.matchesURL(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// entityName returns the name of entity for reporting, which is its first type.
+func (e *jsonLdEntity) entityName() string {
+	if len(e.types) == 0 {
+		return ""
+	}
+	return e.types[0]
+}
+
+// entityTypes returns the types of entity without its vocabulary prefix, e.g.
+// "https://schema.org/NewsArticle" becomes "NewsArticle".
+func entityTypes(value interface{}) []string {
+	var types []string
+	addType := func(item interface{}) {
+		if t := schemaTypeName(jsonString(item)); t != "" {
+			types = append(types, t)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		addType(v)
+	case []interface{}:
+		for _, item := range v {
+			addType(item)
+		}
+	}
+
+	return types
+}
+
+// schemaTypeName returns the type name without its vocabulary prefix.
+func schemaTypeName(t string) string {
+	return t[strings.LastIndexAny(t, "/:#")+1:]
+}
+
+func hasEntityType(e *jsonLdEntity, types map[string]struct{}) bool {
+	for _, t := range e.types {
+		if _, exist := types[t]; exist {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonLdKeys returns the keys of JSON object in sorted order with @graph first, so the
+// entities and their dates are always collected in the same order.
+func jsonLdKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(a, b int) bool {
+		if (keys[a] == "@graph") != (keys[b] == "@graph") {
+			return keys[a] == "@graph"
+		}
+		return keys[a] < keys[b]
+	})
+	return keys
+}
+
+func jsonString(value interface{}) string {
+	str, _ := value.(string)
+	return strings.TrimSpace(str)
+}
+
+// normalizeEntityURL resolves the URL against the page URL, then strips the parts that
+// don't change the page (scheme, "www.", query, fragment and trailing slash) so URLs
+// can be compared.
+func normalizeEntityURL(rawURL, pageURL string) string {
+	parsedURL, err := nurl.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return ""
+	}
+
+	if base, err := nurl.Parse(strings.TrimSpace(pageURL)); err == nil {
+		parsedURL = base.ResolveReference(parsedURL)
+	}
+
+	host := strings.ToLower(parsedURL.Hostname())
+	host = strings.TrimPrefix(host, "www.")
+	if host == "" {
+		return ""
+	}
+
+	return host + strings.TrimSuffix(parsedURL.EscapedPath(), "/")
+}
@@ -0,0 +1,112 @@
+package htmldate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-shiori/dom"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_jsonLdGraph(t *testing.T) {
+	// Helper function
+	rankOf := func(pageURL string, jsonText string) map[string]int {
+		doc, _ := dom.FastParse(strings.NewReader(`<html><head>
+			<script type="application/ld+json">` + jsonText + `</script>
+		</head></html>`))
+
+		ranks := make(map[string]int)
+		for _, ct := range findJsonDateTexts(doc, Options{URL: pageURL, log: newLogger(Options{})}) {
+			ranks[ct.EntityType+" "+ct.Text] = ct.Rank
+		}
+		return ranks
+	}
+
+	// Entity in @graph matched by @id, url or reference to the web page
+	ranks := rankOf("https://example.org/news/story/", `{"@context": "https://schema.org", "@graph": [
+		{"@type": "WebPage", "@id": "https://example.org/news/story#webpage", "url": "https://example.org/news/story", "datePublished": "2021-07-01"},
+		{"@type": "NewsArticle", "@id": "#article", "datePublished": "2021-07-02"},
+		{"@type": "BlogPosting", "mainEntityOfPage": {"@id": "https://example.org/news/story#webpage"}, "datePublished": "2021-07-03"},
+		{"@type": "NewsArticle", "url": "https://example.org/news/other", "datePublished": "2021-07-04"},
+		{"@type": "WebSite", "@id": "https://example.org/#website", "datePublished": "2001-01-01"}
+	]}`)
+
+	assert.Equal(t, jsonRankMatchedPage, ranks["WebPage 2021-07-01"])
+	assert.Equal(t, jsonRankMatched, ranks["NewsArticle 2021-07-02"])
+	assert.Equal(t, jsonRankMatched, ranks["BlogPosting 2021-07-03"])
+	assert.Equal(t, jsonRankArticle, ranks["NewsArticle 2021-07-04"])
+	assert.Equal(t, jsonRankExcluded, ranks["WebSite 2001-01-01"])
+
+	// Nested entities
+	ranks = rankOf("https://example.org/story", `{"@type": "WebPage", "url": "http://www.example.org/story/",
+		"mainEntity": {"@type": "schema:Article", "datePublished": "2021-07-02"},
+		"hasPart": {"@type": "Article", "datePublished": "2021-07-03"},
+		"review": {"@type": "Review", "itemReviewed": {"@type": "Article", "datePublished": "2021-07-04"}}
+	}`)
+
+	assert.Equal(t, jsonRankMatched, ranks["Article 2021-07-02"])
+	assert.Equal(t, jsonRankNested, ranks["Article 2021-07-03"])
+	assert.Equal(t, jsonRankExcluded, ranks["Article 2021-07-04"])
+
+	// Without page URL
+	ranks = rankOf("", `[{"@type": "NewsArticle", "datePublished": "2021-07-02"},
+		{"@type": "AboutPage", "datePublished": "2021-07-03"},
+		{"datePublished": "2021-07-04"}]`)
+
+	assert.Equal(t, jsonRankArticle, ranks["NewsArticle 2021-07-02"])
+	assert.Equal(t, jsonRankPage, ranks["AboutPage 2021-07-03"])
+	assert.Equal(t, jsonRankOther, ranks[" 2021-07-04"])
+}
+
+func Test_JsonLdEntity(t *testing.T) {
+	// Helper function
+	check := func(expected, entityType, entityID string, htmlString string, opts Options) {
+		res := extractFromString(htmlString, opts)
+		assert.Equal(t, expected, res.Format("2006-01-02"), htmlString)
+		assert.Equal(t, MethodJson, res.Method, htmlString)
+		assert.Equal(t, entityType, res.EntityType, htmlString)
+		assert.Equal(t, entityID, res.EntityID, htmlString)
+	}
+
+	original := Options{UseOriginalDate: true}
+	modified := Options{}
+
+	// Comment is newer, but it's not the page
+	htmlString := `<html><head>
+	<script type="application/ld+json">{"@type": "NewsArticle", "datePublished": "2021-07-13", "dateModified": "2021-07-14",
+		"comment": [{"@type": "Comment", "dateCreated": "2021-07-20"}, {"@type": "Comment", "dateCreated": "2021-07-01"}]}</script>
+	</head></html>`
+	check("2021-07-13", "NewsArticle", "", htmlString, original)
+	check("2021-07-14", "NewsArticle", "", htmlString, modified)
+
+	// Related article is older, but the main article matches the canonical URL
+	htmlString = `<html><head>
+	<link rel="canonical" href="https://example.org/2021/story"/>
+	<script type="application/ld+json">{"@context": "https://schema.org", "@graph": [
+		{"@type": "Organization", "@id": "https://example.org/#org", "foundingDate": "1990-01-01", "dateCreated": "2005-01-01"},
+		{"@type": "NewsArticle", "@id": "https://example.org/2019/teaser#article", "url": "https://example.org/2019/teaser", "datePublished": "2019-03-01"},
+		{"@type": "NewsArticle", "@id": "https://example.org/2021/story#article", "datePublished": "2021-07-13"}
+	]}</script>
+	</head></html>`
+	check("2021-07-13", "NewsArticle", "https://example.org/2021/story#article", htmlString, original)
+
+	// Date in the other entities is only used if the page entity doesn't have it
+	htmlString = `<html><head>
+	<script type="application/ld+json">{"@type": "WebPage", "url": "https://example.org/story",
+		"mainEntity": {"@type": "Article", "dateModified": "2021-07-14"}}</script>
+	<script type="application/ld+json">{"@type": "BlogPosting", "datePublished": "2018-01-01"}</script>
+	</head></html>`
+	check("2018-01-01", "BlogPosting", "", htmlString, Options{URL: "https://example.org/story", UseOriginalDate: true})
+	check("2021-07-14", "Article", "", htmlString, Options{URL: "https://example.org/story"})
+
+	// Ties are always resolved in the same order of keys
+	htmlString = `<html><head>
+	<script type="application/ld+json">{"@type": "WebPage",
+		"hasPart": {"@type": "Article", "@id": "#part", "datePublished": "2021-07-13"},
+		"about": {"@type": "BlogPosting", "@id": "#about", "datePublished": "2021-07-13"},
+		"mentions": {"@type": "NewsArticle", "@id": "#mention", "datePublished": "2021-07-13"}}</script>
+	</head></html>`
+	for i := 0; i < 20; i++ {
+		check("2021-07-13", "BlogPosting", "#about", htmlString, original)
+	}
+}
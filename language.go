@@ -0,0 +1,487 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-shiori/dom"
+	dps "github.com/markusmobius/go-dateparser"
+	"golang.org/x/net/html"
+)
+
+// lexicon is the lowercased month and weekday names of a language.
+type lexicon struct {
+	months               map[string]int
+	weekdays             map[string]time.Weekday
+	weekdayAbbreviations map[string]struct{}
+}
+
+// newLexicon creates lexicon from list of names for each month (January first) and
+// each weekday (Sunday first). Abbreviated names are marked by trailing dot.
+func newLexicon(months [12][]string, weekdays [7][]string) *lexicon {
+	lex := &lexicon{
+		months:               make(map[string]int),
+		weekdays:             make(map[string]time.Weekday),
+		weekdayAbbreviations: make(map[string]struct{}),
+	}
+
+	for i, names := range months {
+		for _, name := range names {
+			lex.months[strings.TrimSuffix(name, ".")] = i + 1
+		}
+	}
+
+	for i, names := range weekdays {
+		for _, name := range names {
+			if abbr, isAbbr := strings.CutSuffix(name, "."); isAbbr {
+				lex.weekdayAbbreviations[abbr] = struct{}{}
+				name = abbr
+			}
+			lex.weekdays[name] = time.Weekday(i)
+		}
+	}
+
+	return lex
+}
+
+// lexicons is the month and weekday names for each supported language.
+var lexicons = map[string]*lexicon{
+	"en": newLexicon([12][]string{
+		{"january", "jan."},
+		{"february", "feb."},
+		{"march", "mar."},
+		{"april", "apr."},
+		{"may"},
+		{"june", "jun."},
+		{"july", "jul."},
+		{"august", "aug."},
+		{"september", "sept.", "sep."},
+		{"october", "oct."},
+		{"november", "nov."},
+		{"december", "dec."},
+	}, [7][]string{
+		{"sunday", "sun."},
+		{"monday", "mon."},
+		{"tuesday", "tues.", "tue."},
+		{"wednesday", "wed."},
+		{"thursday", "thurs.", "thur.", "thu."},
+		{"friday", "fri."},
+		{"saturday", "sat."},
+	}),
+
+	"fr": newLexicon([12][]string{
+		{"janvier", "janv."},
+		{"février", "fevrier", "févr.", "fevr.", "fév.", "fev."},
+		{"mars"},
+		{"avril", "avr."},
+		{"mai"},
+		{"juin"},
+		{"juillet", "juil."},
+		{"août", "aout"},
+		{"septembre", "sept."},
+		{"octobre", "oct."},
+		{"novembre", "nov."},
+		{"décembre", "decembre", "déc.", "dec."},
+	}, [7][]string{
+		{"dimanche", "dim."},
+		{"lundi", "lun."},
+		{"mardi", "mar."},
+		{"mercredi", "mer."},
+		{"jeudi", "jeu."},
+		{"vendredi", "ven."},
+		{"samedi", "sam."},
+	}),
+
+	"de": newLexicon([12][]string{
+		{"januar", "jänner", "jan.", "jän."},
+		{"februar", "feber", "feb."},
+		{"märz", "maerz", "mär.", "mrz."},
+		{"april", "apr."},
+		{"mai"},
+		{"juni", "jun."},
+		{"juli", "jul."},
+		{"august", "aug."},
+		{"september", "sept.", "sep."},
+		{"oktober", "okt."},
+		{"november", "nov."},
+		{"dezember", "dez."},
+	}, [7][]string{
+		{"sonntag", "so."},
+		{"montag", "mo."},
+		{"dienstag", "di."},
+		{"mittwoch", "mi."},
+		{"donnerstag", "do."},
+		{"freitag", "fr."},
+		{"samstag", "sonnabend", "sa."},
+	}),
+
+	"id": newLexicon([12][]string{
+		{"januari", "jan."},
+		{"februari", "feb."},
+		{"maret", "mar."},
+		{"april", "apr."},
+		{"mei"},
+		{"juni", "jun."},
+		{"juli", "jul."},
+		{"agustus", "agu.", "agt."},
+		{"september", "sep."},
+		{"oktober", "okt."},
+		{"november", "nopember", "nov."},
+		{"desember", "des."},
+	}, [7][]string{
+		{"minggu", "ahad", "min."},
+		{"senin", "sen."},
+		{"selasa", "sel."},
+		{"rabu", "rab."},
+		{"kamis", "kam."},
+		{"jumat", "jum'at", "jum."},
+		{"sabtu", "sab."},
+	}),
+
+	"tr": newLexicon([12][]string{
+		{"ocak", "oca."},
+		{"şubat", "subat", "şub."},
+		{"mart", "mar."},
+		{"nisan", "nis."},
+		{"mayıs", "mayis", "may."},
+		{"haziran", "haz."},
+		{"temmuz", "tem."},
+		{"ağustos", "agustos", "ağu."},
+		{"eylül", "eylul", "eyl."},
+		{"ekim", "eki."},
+		{"kasım", "kasim", "kas."},
+		{"aralık", "aralik", "ara."},
+	}, [7][]string{
+		{"pazar"},
+		{"pazartesi", "pzt."},
+		{"salı", "sali"},
+		{"çarşamba", "carsamba", "çar."},
+		{"perşembe", "persembe", "per."},
+		{"cuma"},
+		{"cumartesi", "cmt."},
+	}),
+	"es": newLexicon([12][]string{
+		{"enero", "ene."},
+		{"febrero", "feb."},
+		{"marzo", "mar."},
+		{"abril", "abr."},
+		{"mayo", "may."},
+		{"junio", "jun."},
+		{"julio", "jul."},
+		{"agosto", "ago."},
+		{"septiembre", "setiembre", "sept.", "sep.", "set."},
+		{"octubre", "oct."},
+		{"noviembre", "nov."},
+		{"diciembre", "dic."},
+	}, [7][]string{
+		{"domingo", "dom."},
+		{"lunes", "lun."},
+		{"martes", "mar."},
+		{"miércoles", "miercoles", "mié.", "mie."},
+		{"jueves", "jue."},
+		{"viernes", "vie."},
+		{"sábado", "sabado", "sáb.", "sab."},
+	}),
+
+	"it": newLexicon([12][]string{
+		{"gennaio", "gen."},
+		{"febbraio", "feb."},
+		{"marzo", "mar."},
+		{"aprile", "apr."},
+		{"maggio", "mag."},
+		{"giugno", "giu."},
+		{"luglio", "lug."},
+		{"agosto", "ago."},
+		{"settembre", "set."},
+		{"ottobre", "ott."},
+		{"novembre", "nov."},
+		{"dicembre", "dic."},
+	}, [7][]string{
+		{"domenica", "dom."},
+		{"lunedì", "lunedi", "lun."},
+		{"martedì", "martedi", "mar."},
+		{"mercoledì", "mercoledi", "mer."},
+		{"giovedì", "giovedi", "gio."},
+		{"venerdì", "venerdi", "ven."},
+		{"sabato", "sab."},
+	}),
+
+	"pt": newLexicon([12][]string{
+		{"janeiro", "jan."},
+		{"fevereiro", "fev."},
+		{"março", "marco", "mar."},
+		{"abril", "abr."},
+		{"maio", "mai."},
+		{"junho", "jun."},
+		{"julho", "jul."},
+		{"agosto", "ago."},
+		{"setembro", "set."},
+		{"outubro", "out."},
+		{"novembro", "nov."},
+		{"dezembro", "dez."},
+	}, [7][]string{
+		{"domingo", "dom."},
+		{"segunda-feira", "segunda", "seg."},
+		{"terça-feira", "terça", "terca", "ter."},
+		{"quarta-feira", "quarta", "qua."},
+		{"quinta-feira", "quinta", "qui."},
+		{"sexta-feira", "sexta", "sex."},
+		{"sábado", "sabado", "sáb.", "sab."},
+	}),
+
+	"nl": newLexicon([12][]string{
+		{"januari", "jan."},
+		{"februari", "feb."},
+		{"maart", "mrt.", "maa."},
+		{"april", "apr."},
+		{"mei"},
+		{"juni", "jun."},
+		{"juli", "jul."},
+		{"augustus", "aug."},
+		{"september", "sept.", "sep."},
+		{"oktober", "okt."},
+		{"november", "nov."},
+		{"december", "dec."},
+	}, [7][]string{
+		{"zondag", "zo."},
+		{"maandag", "ma."},
+		{"dinsdag", "di."},
+		{"woensdag", "wo."},
+		{"donderdag", "do."},
+		{"vrijdag", "vr."},
+		{"zaterdag", "za."},
+	}),
+
+	// Polish, Czech, Russian and Ukrainian months are written in genitive case
+	// within date, so both nominative and genitive forms are listed.
+	"pl": newLexicon([12][]string{
+		{"styczeń", "stycznia", "styczen", "sty."},
+		{"luty", "lutego", "lut."},
+		{"marzec", "marca", "mar."},
+		{"kwiecień", "kwietnia", "kwiecien", "kwi."},
+		{"maj", "maja"},
+		{"czerwiec", "czerwca", "cze."},
+		{"lipiec", "lipca", "lip."},
+		{"sierpień", "sierpnia", "sierpien", "sie."},
+		{"wrzesień", "września", "wrzesien", "wrzesnia", "wrz."},
+		{"październik", "października", "pazdziernik", "pazdziernika", "paź.", "paz."},
+		{"listopad", "listopada", "lis."},
+		{"grudzień", "grudnia", "grudzien", "gru."},
+	}, [7][]string{
+		{"niedziela", "niedz.", "nd."},
+		{"poniedziałek", "poniedzialek", "pon."},
+		{"wtorek", "wt."},
+		{"środa", "sroda", "śr.", "sr."},
+		{"czwartek", "czw."},
+		{"piątek", "piatek", "pt."},
+		{"sobota", "sob."},
+	}),
+
+	"cs": newLexicon([12][]string{
+		{"leden", "ledna", "led."},
+		{"únor", "února", "unor", "unora", "úno."},
+		{"březen", "března", "brezen", "brezna", "bře."},
+		{"duben", "dubna", "dub."},
+		{"květen", "května", "kveten", "kvetna", "kvě."},
+		{"červen", "června", "cerven", "cervna", "čvn."},
+		{"červenec", "července", "cervenec", "cervence", "čvc."},
+		{"srpen", "srpna", "srp."},
+		{"září", "zari", "zář."},
+		{"říjen", "října", "rijen", "rijna", "říj."},
+		{"listopad", "listopadu", "lis."},
+		{"prosinec", "prosince", "pro."},
+	}, [7][]string{
+		{"neděle", "nedele", "ne."},
+		{"pondělí", "pondeli", "po."},
+		{"úterý", "utery", "út."},
+		{"středa", "streda", "st."},
+		{"čtvrtek", "ctvrtek", "čt."},
+		{"pátek", "patek", "pá."},
+		{"sobota", "so."},
+	}),
+
+	"ru": newLexicon([12][]string{
+		{"январь", "января", "янв."},
+		{"февраль", "февраля", "февр.", "фев."},
+		{"март", "марта", "мар."},
+		{"апрель", "апреля", "апр."},
+		{"май", "мая"},
+		{"июнь", "июня", "июн."},
+		{"июль", "июля", "июл."},
+		{"август", "августа", "авг."},
+		{"сентябрь", "сентября", "сент.", "сен."},
+		{"октябрь", "октября", "окт."},
+		{"ноябрь", "ноября", "нояб.", "ноя."},
+		{"декабрь", "декабря", "дек."},
+	}, [7][]string{
+		{"воскресенье", "вс."},
+		{"понедельник", "пн."},
+		{"вторник", "вт."},
+		{"среда", "ср."},
+		{"четверг", "чт."},
+		{"пятница", "пт."},
+		{"суббота", "сб."},
+	}),
+
+	"uk": newLexicon([12][]string{
+		{"січень", "січня", "січ."},
+		{"лютий", "лютого", "лют."},
+		{"березень", "березня", "бер."},
+		{"квітень", "квітня", "квіт.", "кві."},
+		{"травень", "травня", "трав.", "тра."},
+		{"червень", "червня", "черв.", "чер."},
+		{"липень", "липня", "лип."},
+		{"серпень", "серпня", "серп.", "сер."},
+		{"вересень", "вересня", "вер."},
+		{"жовтень", "жовтня", "жовт.", "жов."},
+		{"листопад", "листопада", "лист.", "лис."},
+		{"грудень", "грудня", "груд.", "гру."},
+	}, [7][]string{
+		{"неділя", "нд."},
+		{"понеділок", "пн."},
+		{"вівторок", "вт."},
+		{"середа", "ср."},
+		{"четвер", "чт."},
+		{"пʼятниця", "п'ятниця", "пт."},
+		{"субота", "сб."},
+	}),
+
+	"hu": newLexicon([12][]string{
+		{"január", "januar", "jan."},
+		{"február", "februar", "febr.", "feb."},
+		{"március", "marcius", "márc.", "marc."},
+		{"április", "aprilis", "ápr.", "apr."},
+		{"május", "majus", "máj.", "maj."},
+		{"június", "junius", "jún.", "jun."},
+		{"július", "julius", "júl.", "jul."},
+		{"augusztus", "aug."},
+		{"szeptember", "szept.", "szep."},
+		{"október", "oktober", "okt."},
+		{"november", "nov."},
+		{"december", "dec."},
+	}, [7][]string{
+		{"vasárnap", "vasarnap", "vas."},
+		{"hétfő", "hetfo", "hét."},
+		{"kedd"},
+		{"szerda", "sze.", "szer."},
+		{"csütörtök", "csutortok", "csüt.", "cs."},
+		{"péntek", "pentek", "pén."},
+		{"szombat", "szo.", "szomb."},
+	}),
+}
+
+// detectLanguages looks for the languages of the page from `<html lang>`,
+// `content-language` meta and `og:locale`.
+func detectLanguages(doc *html.Node) []string {
+	var languages []string
+	for _, tag := range pageLocales(doc) {
+		if lang := normalizeLanguage(tag); lang != "" && !slices.Contains(languages, lang) {
+			languages = append(languages, lang)
+		}
+	}
+	return languages
+}
+
+// pageLocales returns the raw language tags of the page (e.g. "en-US" or "pt_BR") from
+// `<html lang>`, `content-language` meta and `og:locale`.
+func pageLocales(doc *html.Node) []string {
+	var tags []string
+	addTags := func(values string) {
+		for _, value := range strings.Split(values, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				tags = append(tags, value)
+			}
+		}
+	}
+
+	if htmlNode := dom.QuerySelector(doc, "html"); htmlNode != nil {
+		addTags(dom.GetAttribute(htmlNode, "lang"))
+	}
+
+	for _, meta := range dom.GetElementsByTagName(doc, "meta") {
+		httpEquiv := strings.ToLower(dom.GetAttribute(meta, "http-equiv"))
+		property := strings.ToLower(dom.GetAttribute(meta, "property"))
+		if httpEquiv == "content-language" || property == "og:locale" {
+			addTags(dom.GetAttribute(meta, "content"))
+		}
+	}
+
+	return tags
+}
+
+// normalizeLanguage converts language tag (e.g. "en-US" or "pt_BR") into its primary
+// language code (e.g. "en" or "pt").
+func normalizeLanguage(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if idx := strings.IndexAny(tag, "-_"); idx >= 0 {
+		tag = tag[:idx]
+	}
+
+	if len(tag) < 2 || len(tag) > 3 {
+		return ""
+	}
+
+	for _, r := range tag {
+		if r < 'a' || r > 'z' {
+			return ""
+		}
+	}
+
+	return tag
+}
+
+// dpsLanguages caches whether a language is supported by the external `dateparser`.
+var dpsLanguages sync.Map
+
+// dpsSupportsLanguage checks if the language is supported by the external `dateparser`,
+// since it refuses to parse anything when one of the specified language is unknown.
+func dpsSupportsLanguage(lang string, parser *dps.Parser) bool {
+	if supported, cached := dpsLanguages.Load(lang); cached {
+		return supported.(bool)
+	}
+
+	cfg := &dps.Configuration{Languages: []string{lang}}
+	_, err := parser.Parse(cfg, "2020")
+	supported := err == nil || !strings.HasPrefix(err.Error(), "unknown language")
+	dpsLanguages.Store(lang, supported)
+	return supported
+}
+
+// applyLanguages configures the external `dateparser` to use the languages of the page,
+// unless its languages or locales are explicitly specified.
+func (opts Options) applyLanguages() Options {
+	cfg := opts.parserConfig
+	if cfg == nil || len(cfg.Languages) > 0 || len(cfg.Locales) > 0 {
+		return opts
+	}
+
+	var languages []string
+	for _, lang := range opts.languages {
+		if dpsSupportsLanguage(lang, opts.dateParser()) {
+			languages = append(languages, lang)
+		}
+	}
+
+	if len(languages) > 0 {
+		opts.parserConfig = cfg.Clone()
+		opts.parserConfig.Languages = languages
+	}
+
+	return opts
+}
@@ -0,0 +1,254 @@
+package htmldate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-shiori/dom"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_detectLanguages(t *testing.T) {
+	// Helper function
+	detect := func(str string) []string {
+		doc, err := dom.Parse(strings.NewReader(str))
+		assert.NoError(t, err)
+		return detectLanguages(doc)
+	}
+
+	assert.Empty(t, detect(`<html><body></body></html>`))
+	assert.Equal(t, []string{"de"}, detect(`<html lang="de-AT"><body></body></html>`))
+	assert.Equal(t, []string{"pt", "en"}, detect(`<html lang="pt-BR"><head>
+		<meta http-equiv="Content-Language" content="pt-BR, en"/>
+		<meta property="og:locale" content="en_US"/>
+	</head></html>`))
+	assert.Equal(t, []string{"fr"}, detect(`<html><head>
+		<meta property="og:locale" content="fr_FR"/>
+	</head></html>`))
+}
+
+func Test_normalizeLanguage(t *testing.T) {
+	assert.Equal(t, "en", normalizeLanguage("en"))
+	assert.Equal(t, "en", normalizeLanguage(" EN-us "))
+	assert.Equal(t, "pt", normalizeLanguage("pt_BR"))
+	assert.Equal(t, "fil", normalizeLanguage("fil"))
+	assert.Equal(t, "", normalizeLanguage(""))
+	assert.Equal(t, "", normalizeLanguage("x"))
+	assert.Equal(t, "", normalizeLanguage("english"))
+	assert.Equal(t, "", normalizeLanguage("*"))
+}
+
+func Test_Languages(t *testing.T) {
+	// Invalid language
+	_, err := NewExtractor(Options{Languages: []string{"english"}})
+	assert.Error(t, err)
+
+	// Languages in options are normalized
+	e, err := NewExtractor(Options{Languages: []string{"de-DE", "de_AT", "en"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"de", "en"}, e.Options().languages)
+
+	// Languages are used for external date parser, except the unknown ones
+	opts := e.Options()
+	opts.languages = []string{"de", "xx"}
+	opts = opts.applyLanguages()
+	assert.Equal(t, []string{"de"}, opts.parserConfig.Languages)
+	assert.Empty(t, e.Options().parserConfig.Languages)
+
+	// Explicit languages in date parser config are kept
+	opts = e.Options()
+	opts.parserConfig = opts.parserConfig.Clone()
+	opts.parserConfig.Languages = []string{"fr"}
+	opts.languages = []string{"de"}
+	assert.Equal(t, []string{"fr"}, opts.applyLanguages().parserConfig.Languages)
+
+	// Date parser should use the detected language
+	str := `<html lang="es"><body><p class="date">15 de octubre de 2019</p></body></html>`
+	res, err := FromReader(strings.NewReader(str), Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2019-10-15", res.Format("2006-01-02"))
+}
+
+func Test_monthNameParse(t *testing.T) {
+	// Helper function
+	parse := func(s string, languages ...string) string {
+		opts := Options{
+			MinDate:   defaultMinDate,
+			MaxDate:   testMaxDate,
+			languages: languages,
+		}
+
+		dt := regexParse(s, opts)
+		if dt.IsZero() {
+			return ""
+		}
+		return dt.Format("2006-01-02")
+	}
+
+	// Full month names, including the inflected forms
+	fullNameTests := map[string][]string{
+		"2021-07-13": {
+			"13 de julio de 2021",      // es
+			"13 luglio 2021",           // it
+			"13 de julho de 2021",      // pt
+			"13 juli 2021",             // nl
+			"13 lipca 2021 r.",         // pl
+			"13. července 2021",        // cs
+			"13 июля 2021 года",        // ru
+			"13 липня 2021 року",       // uk
+			"Publié le 13 Luglio 2021", // mixed case
+		},
+		"2021-03-02": {
+			"2 marzo 2021",
+			"2 de março de 2021",
+			"2 maart 2021",
+			"2 marca 2021",
+			"2. března 2021",
+			"2 марта 2021",
+			"2 березня 2021",
+		},
+		"2021-12-24": {
+			"diciembre 24, 2021",
+			"24 grudnia 2021",
+			"24 декабря 2021",
+			"24. prosince 2021",
+		},
+	}
+
+	for expected, texts := range fullNameTests {
+		for _, text := range texts {
+			assert.Equal(t, expected, parse(text), text)
+		}
+	}
+
+	// Hungarian year first dates
+	assert.Equal(t, "2021-07-13", parse("2021. július 13."))
+	assert.Equal(t, "2021-07-13", parse("2021. július 13., kedd"))
+	assert.Equal(t, "2021-07-13", parse("2021. júl. 13."))
+	assert.Equal(t, "", parse("2021. július 32."))
+	assert.Equal(t, "", parse("2021 július 13"))
+
+	// Abbreviations are only used in day-month order
+	assert.Equal(t, "2021-07-13", parse("13 lip. 2021"))
+	assert.Equal(t, "2020-10-05", parse("5 окт. 2020"))
+	assert.Equal(t, "2020-10-05", parse("5 ott 2020"))
+	assert.Equal(t, "2020-03-05", parse("5 mrt 2020"))
+	assert.Equal(t, "", parse("ott 5, 2020"))
+	assert.Equal(t, "", parse("iPhone 13 Pro 2021"))
+
+	// Month names don't depend on the languages of the page
+	assert.Equal(t, "2021-07-13", parse("13 lipca 2021", "ru"))
+	assert.Equal(t, "2021-07-13", parse("13 July 2021", "en"))
+
+	// Invalid dates
+	assert.Equal(t, "", parse("32 июля 2021"))
+	assert.Equal(t, "", parse("13 июля 1990"))
+	assert.Equal(t, "", parse("13 domingo 2021"))
+}
+
+func Test_verboseDates(t *testing.T) {
+	// Helper function
+	try := func(s string, languages ...string) string {
+		opts := Options{
+			MinDate:             defaultMinDate,
+			MaxDate:             testMaxDate,
+			SkipExtensiveSearch: true,
+			languages:           languages,
+		}
+
+		_, dt := tryDateExpr(s, opts)
+		if dt.IsZero() {
+			return ""
+		}
+		return dt.Format("2006-01-02")
+	}
+
+	// Verbose dates for each language, all of them are 13 July 2021 or 1 July 2021
+	corpus := []struct {
+		language string
+		text     string
+		expected string
+	}{
+		{"en", "13th of July, 2021", "2021-07-13"},
+		{"en", "Tuesday, the 13th of July, 2021 at 5:30 pm", "2021-07-13"},
+		{"en", "July 13th, 2021 at 5:30 pm", "2021-07-13"},
+		{"en", "July the 13th, 2021", "2021-07-13"},
+		{"en", "on the 1st of July, 2021", "2021-07-01"},
+		{"en", "July 1st 2021, 5:30pm", "2021-07-01"},
+		{"fr", "le 1er juillet 2021", "2021-07-01"},
+		{"fr", "le jeudi 1er juil. 2021 à 17h30", "2021-07-01"},
+		{"fr", "mardi 13 juillet 2021 à 17h30", "2021-07-13"},
+		{"de", "Dienstag, den 13. Juli 2021 um 17:30 Uhr", "2021-07-13"},
+		{"de", "am 1. Juli 2021", "2021-07-01"},
+		{"id", "Selasa, 13 Juli 2021 pukul 17.30", "2021-07-13"},
+		{"tr", "13 Temmuz 2021 Salı", "2021-07-13"},
+		{"es", "1º de julio de 2021", "2021-07-01"},
+		{"es", "jueves, 1.º de julio de 2021", "2021-07-01"},
+		{"pt", "1º de julho de 2021", "2021-07-01"},
+		{"pt", "terça-feira, 13 de julho de 2021 às 17h30", "2021-07-13"},
+		{"it", "1º luglio 2021", "2021-07-01"},
+		{"it", "martedì 13 luglio 2021 alle 17:30", "2021-07-13"},
+		{"nl", "1e juli 2021", "2021-07-01"},
+		{"nl", "1ste juli 2021", "2021-07-01"},
+		{"nl", "dinsdag 13de juli 2021 om 17:30", "2021-07-13"},
+		{"pl", "13-go lipca 2021", "2021-07-13"},
+		{"pl", "wtorek, 13 lipca 2021 r.", "2021-07-13"},
+		{"cs", "úterý 13. července 2021", "2021-07-13"},
+		{"ru", "13-го июля 2021 года", "2021-07-13"},
+		{"ru", "вторник, 13 июля 2021 г.", "2021-07-13"},
+		{"uk", "13-го липня 2021 року", "2021-07-13"},
+	}
+
+	for _, c := range corpus {
+		assert.Equal(t, c.expected, try(c.text, c.language), c.text)
+	}
+
+	// Verbose dates don't depend on the languages of the page
+	assert.Equal(t, "2021-07-01", try("le 1er juillet 2021"))
+	assert.Equal(t, "2021-07-13", try("13-го июля 2021 года"))
+	assert.Equal(t, "2021-07-01", try("le 1er juil. 2021"))
+
+	// Ordinal must be attached to a valid day and month
+	assert.Equal(t, "", try("le 1er truc 2021", "fr"))
+	assert.Equal(t, "", try("32nd of July, 2021", "en"))
+	assert.Equal(t, "", try("July the 31th, 1990", "en"))
+}
+
+func Test_MonthNameFastMode(t *testing.T) {
+	str := `<html lang="pl"><body>
+		<p class="date">Opublikowano: 13 lip 2021</p>
+	</body></html>`
+
+	res, err := FromReader(strings.NewReader(str), Options{SkipExtensiveSearch: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-07-13", res.Format("2006-01-02"))
+
+	str = `<html><body><p class="date">Опубликовано 13 июля 2021</p></body></html>`
+	res, err = FromReader(strings.NewReader(str), Options{SkipExtensiveSearch: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-07-13", res.Format("2006-01-02"))
+
+	// Abbreviated month names are found with or without configured languages
+	configuredTests := []struct {
+		language string
+		text     string
+		expected string
+	}{
+		{"pl", "Opublikowano: 13 lip 2021", "2021-07-13"},
+		{"nl", "Gepubliceerd op 2 mrt. 2021", "2021-03-02"},
+	}
+
+	for _, test := range configuredTests {
+		str = `<html><body><p class="date">` + test.text + `</p></body></html>`
+		res, err = FromReader(strings.NewReader(str), Options{SkipExtensiveSearch: true})
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, res.Format("2006-01-02"), test.text)
+
+		res, err = FromReader(strings.NewReader(str), Options{
+			SkipExtensiveSearch: true,
+			Languages:           []string{test.language},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, res.Format("2006-01-02"), test.text)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+// logger is the logger for a single extraction. The zero value is a valid logger
+// that discards every messages.
+type logger struct {
+	sl  *slog.Logger
+	ctx context.Context
+}
+
+// newLogger creates logger for the extraction that uses the specified options.
+func newLogger(opts Options) logger {
+	switch {
+	case opts.Logger != nil:
+		return logger{sl: opts.Logger}
+	case opts.EnableLog:
+		handler := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
+		return logger{sl: slog.New(handler)}
+	default:
+		return logger{}
+	}
+}
+
+// Debugf logs message in debug level.
+func (l logger) Debugf(format string, args ...any) {
+	l.logf(slog.LevelDebug, format, args...)
+}
+
+func (l logger) logf(level slog.Level, format string, args ...any) {
+	// Use context of the extraction, so handlers can read its values
+	ctx := l.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// Check level first to avoid formatting the discarded message
+	if l.sl == nil || !l.sl.Enabled(ctx, level) {
+		return
+	}
+
+	l.sl.Log(ctx, level, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Logger(t *testing.T) {
+	url := "http://blog.python.org/2016/12/python-360-is-now-available.html"
+
+	// Helper function
+	newBufferLogger := func(level slog.Level) (*bytes.Buffer, *slog.Logger) {
+		buffer := bytes.NewBuffer(nil)
+		handler := slog.NewTextHandler(buffer, &slog.HandlerOptions{Level: level})
+		return buffer, slog.New(handler)
+	}
+
+	// Run verbose and silent extractions concurrently
+	var wg sync.WaitGroup
+	buffers := make([]*bytes.Buffer, 8)
+	for i := range buffers {
+		var logger *slog.Logger
+		if i%2 == 0 {
+			buffers[i], logger = newBufferLogger(slog.LevelDebug)
+		} else {
+			buffers[i], logger = newBufferLogger(slog.LevelInfo)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			extractMockFile(url, Options{Logger: logger})
+		}()
+	}
+	wg.Wait()
+
+	// Only the debug loggers should receive the messages
+	for i, buffer := range buffers {
+		if i%2 == 0 {
+			assert.Contains(t, buffer.String(), "level=DEBUG")
+		} else {
+			assert.Empty(t, buffer.String())
+		}
+	}
+
+	// Zero logger should be usable
+	assert.NotPanics(t, func() { logger{}.Debugf("test %d", 1) })
+}
+
+// ctxKey is the key of context value that read by ctxHandler.
+type ctxKey struct{}
+
+// ctxHandler is log handler that records the context value of every logged message.
+type ctxHandler struct {
+	mu     sync.Mutex
+	values []any
+}
+
+func (h *ctxHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *ctxHandler) WithAttrs([]slog.Attr) slog.Handler       { return h }
+func (h *ctxHandler) WithGroup(string) slog.Handler            { return h }
+
+func (h *ctxHandler) Handle(ctx context.Context, _ slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.values = append(h.values, ctx.Value(ctxKey{}))
+	return nil
+}
+
+func Test_LoggerContext(t *testing.T) {
+	handler := &ctxHandler{}
+	e, err := NewExtractor(Options{Logger: slog.New(handler)})
+	assert.NoError(t, err)
+
+	f := openMockFile("http://blog.python.org/2016/12/python-360-is-now-available.html")
+	defer f.Close()
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request-1")
+	_, err = e.Extract(ctx, f)
+	assert.NoError(t, err)
+
+	// Every message should be logged with context of the extraction
+	assert.NotEmpty(t, handler.values)
+	for _, value := range handler.values {
+		assert.Equal(t, "request-1", value)
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+var (
+	// rdfaPrefixes is the vocabulary IRIs that commonly used in RDFa, along with
+	// their conventional prefix.
+	rdfaPrefixes = map[string]string{
+		"http://purl.org/dc/terms/":        "dcterms:",
+		"http://purl.org/dc/elements/1.1/": "dc:",
+		"http://schema.org/":               "schema:",
+		"https://schema.org/":              "schema:",
+	}
+
+	// rdfaOriginalProps and rdfaModifiedProps is the RDFa properties for the original
+	// and modified date. Property without prefix is used with schema.org vocabulary.
+	rdfaOriginalProps = sliceToMap("dc:date", "dc:created", "dc:issued", "dcterms:date",
+		"dcterms:created", "dcterms:issued", "schema:datepublished", "schema:datecreated",
+		"datepublished", "datecreated")
+	rdfaModifiedProps = sliceToMap("dc:modified", "dcterms:modified",
+		"schema:datemodified", "datemodified")
+)
+
+// itemScope is the item in microdata (marked by `itemscope`) or the typed resource in
+// RDFa (marked by `typeof`) where the date properties belong to.
+type itemScope struct {
+	types []string
+	id    string
+	depth int
+}
+
+// itemProperty is the date property found in an item.
+type itemProperty struct {
+	name     string
+	value    string
+	node     *html.Node
+	scope    *itemScope
+	original bool
+	excluded bool
+}
+
+// examineMicrodata looks for the date in microdata and RDFa properties on any element,
+// e.g. `<span itemprop="datePublished" content="2021-07-13">` or `<time property=
+// "dc:date">`. Dates of items nested inside comment, review and the other items that
+// don't describe the page are ignored. Article is preferred over web page and the other
+// items, then the outermost and the first one in the document.
+func examineMicrodata(doc *html.Node, opts Options) (string, time.Time) {
+	// Check the best items first
+	props := findItemProperties(doc)
+	sort.SliceStable(props, func(a, b int) bool {
+		rankA, rankB := props[a].scope.rank(), props[b].scope.rank()
+		if rankA != rankB {
+			return rankA > rankB
+		}
+		return props[a].scope.depth < props[b].scope.depth
+	})
+
+	// Parse date for each properties
+	var bestScope *itemScope
+	var bestText string
+	var bestDate time.Time
+	for _, prop := range props {
+		if prop.excluded || prop.original != opts.UseOriginalDate {
+			continue
+		}
+
+		opts.log.Debugf("examining item property %s: %s", prop.name, prop.value)
+		propOpts := opts.from(MethodMicrodata, prop.node, prop.name).
+			inEntity(prop.scope.typeName(), prop.scope.id)
+		if bestScope != nil && prop.scope != bestScope {
+			propOpts = propOpts.asReserve()
+		}
+
+		rawString, dt := tryStructuredDate(prop.value, propOpts)
+		if dt.IsZero() {
+			continue
+		}
+
+		// Only compare the dates within the best item
+		if bestScope == nil {
+			bestScope = prop.scope
+		}
+
+		if prop.scope == bestScope && (bestDate.IsZero() ||
+			(opts.UseOriginalDate && dt.Before(bestDate)) ||
+			(!opts.UseOriginalDate && dt.After(bestDate))) {
+			bestText, bestDate = rawString, dt
+		}
+
+		if !opts.exhaustive() && prop.scope != bestScope {
+			break
+		}
+	}
+
+	return bestText, bestDate
+}
+
+// findItemProperties walks through the document and collects the date properties,
+// along with the item where they belong to. Microdata and RDFa have their own items,
+// but both are excluded when nested inside comment, review, etc.
+func findItemProperties(doc *html.Node) []itemProperty {
+	var props []itemProperty
+	var walk func(node *html.Node, microItem, rdfaItem *itemScope, excluded bool)
+	walk = func(node *html.Node, microItem, rdfaItem *itemScope, excluded bool) {
+		if node.Type != html.ElementNode && node.Type != html.DocumentNode {
+			return
+		}
+
+		// Property on an element belongs to the current item, even if the element
+		// starts a new item itself
+		if node.Type == html.ElementNode {
+			for _, name := range strings.Fields(dom.GetAttribute(node, "itemprop")) {
+				lowerName := strings.ToLower(name)
+				if inMap(lowerName, itemPropAttrKeys) {
+					props = append(props, itemProperty{
+						name:     name,
+						value:    microdataValue(node),
+						node:     node,
+						scope:    microItem,
+						original: inMap(lowerName, itemPropOriginal),
+						excluded: excluded,
+					})
+				}
+			}
+
+			for _, name := range strings.Fields(dom.GetAttribute(node, "property")) {
+				prop := normalizeRdfaProperty(name)
+				inOriginal := inMap(prop, rdfaOriginalProps)
+				if inOriginal || inMap(prop, rdfaModifiedProps) {
+					props = append(props, itemProperty{
+						name:     name,
+						value:    rdfaValue(node),
+						node:     node,
+						scope:    rdfaItem,
+						original: inOriginal,
+						excluded: excluded,
+					})
+				}
+			}
+
+			// Start a new item if necessary
+			if dom.HasAttribute(node, "itemscope") {
+				microItem = newItemScope(microItem, dom.GetAttribute(node, "itemtype"),
+					dom.GetAttribute(node, "itemid"))
+				excluded = excluded || microItem.isExcluded()
+			}
+
+			if dom.HasAttribute(node, "typeof") {
+				id := dom.GetAttribute(node, "resource")
+				if strings.TrimSpace(id) == "" {
+					id = dom.GetAttribute(node, "about")
+				}
+
+				rdfaItem = newItemScope(rdfaItem, dom.GetAttribute(node, "typeof"), id)
+				excluded = excluded || rdfaItem.isExcluded()
+			}
+		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child, microItem, rdfaItem, excluded)
+		}
+	}
+
+	walk(doc, &itemScope{}, &itemScope{}, false)
+	return props
+}
+
+// newItemScope creates the item that nested inside the parent item.
+func newItemScope(parent *itemScope, types, id string) *itemScope {
+	scope := &itemScope{
+		id:    strings.TrimSpace(id),
+		depth: parent.depth + 1,
+	}
+
+	for _, t := range strings.Fields(types) {
+		scope.types = append(scope.types, schemaTypeName(t))
+	}
+
+	return scope
+}
+
+// isExcluded checks if the item never describes the page, e.g. comment.
+func (s *itemScope) isExcluded() bool {
+	for _, t := range s.types {
+		if _, exist := schemaExcludedTypes[t]; exist {
+			return true
+		}
+	}
+	return false
+}
+
+// rank returns how likely the item describes the page, i.e. article is preferred over
+// web page, then the other items.
+func (s *itemScope) rank() int {
+	for _, t := range s.types {
+		if _, exist := jsonArticleTypes[t]; exist {
+			return jsonRankArticle
+		}
+	}
+
+	for _, t := range s.types {
+		if _, exist := jsonPageTypes[t]; exist {
+			return jsonRankPage
+		}
+	}
+
+	return jsonRankOther
+}
+
+// microdataValue returns the value of microdata property. Beside the values defined in
+// HTML spec, `content` attribute is accepted in any element since it's common in the wild.
+func microdataValue(node *html.Node) string {
+	if dom.HasAttribute(node, "content") {
+		return dom.GetAttribute(node, "content")
+	}
+
+	switch dom.TagName(node) {
+	case "time":
+		if dom.HasAttribute(node, "datetime") {
+			return dom.GetAttribute(node, "datetime")
+		}
+	case "data", "meter":
+		return dom.GetAttribute(node, "value")
+	}
+
+	return dom.TextContent(node)
+}
+
+// rdfaValue returns the value of RDFa property, i.e. its `content` attribute or its
+// text. Since `<time>` is commonly used for typed date, its `datetime` is accepted as well.
+func rdfaValue(node *html.Node) string {
+	if dom.HasAttribute(node, "content") {
+		return dom.GetAttribute(node, "content")
+	}
+
+	if dom.TagName(node) == "time" && dom.HasAttribute(node, "datetime") {
+		return dom.GetAttribute(node, "datetime")
+	}
+
+	return dom.TextContent(node)
+}
+
+// normalizeRdfaProperty returns the RDFa property in lowercase, where the vocabulary
+// IRI is replaced by its conventional prefix, e.g. "http://purl.org/dc/terms/created"
+// becomes "dcterms:created".
+func normalizeRdfaProperty(property string) string {
+	for iri, prefix := range rdfaPrefixes {
+		if strings.HasPrefix(property, iri) {
+			property = prefix + strings.TrimPrefix(property, iri)
+			break
+		}
+	}
+	return strings.ToLower(property)
+}
+
+// typeName returns the name of item for reporting, which is its first type.
+func (s *itemScope) typeName() string {
+	if len(s.types) == 0 {
+		return ""
+	}
+	return s.types[0]
+}
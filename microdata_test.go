@@ -0,0 +1,89 @@
+package htmldate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_examineMicrodata(t *testing.T) {
+	// Helper function
+	check := func(expected, entityType string, htmlString string, opts Options) {
+		res := extractFromString(htmlString, opts)
+		assert.Equal(t, expected, res.Format("2006-01-02"), htmlString)
+		assert.Equal(t, MethodMicrodata, res.Method, htmlString)
+		assert.Equal(t, entityType, res.EntityType, htmlString)
+	}
+
+	original := Options{UseOriginalDate: true, SkipExtensiveSearch: true}
+	modified := Options{SkipExtensiveSearch: true}
+
+	// Microdata in the other elements than <meta>
+	check("2021-07-13", "NewsArticle", `<html><body><article itemscope itemtype="https://schema.org/NewsArticle">
+		<span itemprop="datePublished" content="2021-07-13T10:00:00+02:00">13 July</span>
+	</article></body></html>`, original)
+	check("2021-07-13", "BlogPosting", `<html><body><div itemscope itemtype="http://schema.org/BlogPosting">
+		<time itemprop="datePublished" datetime="2021-07-13">Tuesday</time>
+	</div></body></html>`, original)
+	check("2021-07-13", "Article", `<html><body><div itemscope itemtype="https://schema.org/Article">
+		Posted <span itemprop="dateCreated">July 13, 2021</span>
+	</div></body></html>`, original)
+	check("2021-07-14", "Article", `<html><body><div itemscope itemtype="https://schema.org/Article">
+		<span itemprop="datePublished">2021-07-13</span>
+		<span itemprop="dateModified">2021-07-14</span>
+	</div></body></html>`, modified)
+
+	// Dates in nested comments and reviews are ignored
+	htmlString := `<html><body><article itemscope itemtype="https://schema.org/Article">
+		<time itemprop="datePublished" datetime="2021-07-13">13 July</time>
+		<div itemprop="comment" itemscope itemtype="https://schema.org/Comment">
+			<time itemprop="dateCreated" datetime="2021-07-01">1 July</time>
+			<div itemprop="about" itemscope itemtype="https://schema.org/Thing">
+				<time itemprop="datePublished" datetime="2021-06-01">1 June</time>
+			</div>
+		</div>
+	</article></body></html>`
+	check("2021-07-13", "Article", htmlString, original)
+
+	// Article is preferred over web page, then the outer and the first item
+	check("2021-07-13", "Article", `<html><body itemscope itemtype="https://schema.org/WebPage">
+		<span itemprop="dateCreated">2021-07-01</span>
+		<div itemscope itemtype="https://schema.org/Article">
+			<span itemprop="datePublished">2021-07-13</span>
+		</div>
+	</body></html>`, original)
+	check("2021-07-13", "NewsArticle", `<html><body><div itemscope itemtype="https://schema.org/NewsArticle">
+		<span itemprop="datePublished">2021-07-13</span>
+		<div itemprop="hasPart" itemscope itemtype="https://schema.org/Article">
+			<span itemprop="datePublished">2021-07-01</span>
+		</div>
+	</div></body></html>`, original)
+	check("2021-07-13", "Article", `<html><body>
+		<div itemscope itemtype="https://schema.org/Article"><time itemprop="datePublished">2021-07-13</time></div>
+		<div itemscope itemtype="https://schema.org/Article"><time itemprop="datePublished">2021-07-12</time></div>
+		<div itemscope itemtype="https://schema.org/Article"><time itemprop="datePublished">2021-07-01</time></div>
+	</body></html>`, original)
+
+	// Review is never the page itself, so its date is left to the other stages
+	res := extractFromString(`<html><body><div itemscope itemtype="https://schema.org/Review">
+		<span itemprop="datePublished">2021-07-01</span>
+	</div></body></html>`, original)
+	assert.NotEqual(t, MethodMicrodata, res.Method)
+
+	// RDFa
+	check("2021-07-13", "", `<html><body><div>
+		<span property="dc:date" content="2021-07-13">13 July</span>
+	</div></body></html>`, original)
+	check("2021-07-13", "BlogPosting", `<html><body><div vocab="http://schema.org/" typeof="BlogPosting">
+		<span property="datePublished" datatype="xsd:date">2021-07-13</span>
+	</div></body></html>`, original)
+	check("2021-07-14", "", `<html><body><div>
+		<time property="http://purl.org/dc/terms/modified" datetime="2021-07-14T08:00:00Z">yesterday</time>
+	</div></body></html>`, modified)
+	check("2021-07-13", "Article", `<html><body><div typeof="schema:Article">
+		<span property="schema:datePublished">2021-07-13</span>
+		<div property="schema:comment" typeof="schema:Comment">
+			<span property="schema:dateCreated">2021-06-01</span>
+		</div>
+	</div></body></html>`, original)
+}
@@ -22,8 +22,12 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
+// testMaxDate is the latest acceptable date used in tests.
+var testMaxDate = defaultMaxDate(time.Now())
+
 // openMockFile is used to open HTML document from specified mock file.
 // Make sure to close the reader later.
 func openMockFile(url string) io.ReadCloser {
@@ -95,11 +99,36 @@ func mergeOpts(opt1, opt2 Options) Options {
 	opt1.UseOriginalDate = opt1.UseOriginalDate || opt2.UseOriginalDate
 	opt1.SkipExtensiveSearch = opt1.SkipExtensiveSearch || opt2.SkipExtensiveSearch
 	opt1.DeferUrlExtractor = opt1.DeferUrlExtractor || opt2.DeferUrlExtractor
+	opt1.RelativeDates = opt1.RelativeDates || opt2.RelativeDates
 
 	if opt2.URL != "" {
 		opt1.URL = opt2.URL
 	}
 
+	if opt2.Logger != nil {
+		opt1.Logger = opt2.Logger
+	}
+
+	if opt2.Pipeline != nil {
+		opt1.Pipeline = opt2.Pipeline
+	}
+
+	if opt2.DateKeys != nil {
+		opt1.DateKeys = opt2.DateKeys
+	}
+
+	if opt2.DateParserConfig != nil {
+		opt1.DateParserConfig = opt2.DateParserConfig
+	}
+
+	if opt2.Languages != nil {
+		opt1.Languages = opt2.Languages
+	}
+
+	if opt2.Timeout != 0 {
+		opt1.Timeout = opt2.Timeout
+	}
+
 	if !opt2.MinDate.IsZero() {
 		opt1.MinDate = opt2.MinDate
 	}
@@ -108,6 +137,10 @@ func mergeOpts(opt1, opt2 Options) Options {
 		opt1.MaxDate = opt2.MaxDate
 	}
 
+	if !opt2.Now.IsZero() {
+		opt1.Now = opt2.Now
+	}
+
 	return opt1
 }
 
@@ -15,6 +15,31 @@ type Result struct {
 	HasTimezone bool
 	// SrcString is the source where the date and time extracted.
 	SrcString string
+	// Method is the extraction method that found the date. Useful to decide how far
+	// the result can be trusted, e.g. JSON-LD is more reliable than the page search.
+	Method Method
+	// ElementPath is the path of element where the date found, e.g. "html > head > meta".
+	// Empty if the date is not found in an element, e.g. in URL or raw page HTML.
+	ElementPath string
+	// Attribute is the attribute name or JSON key that marks the date, e.g. "datetime" for
+	// <time> or "article:published_time" for <meta>. Empty if found in text content.
+	Attribute string
+	// EntityType is the schema.org type of JSON-LD entity or microdata item where the date
+	// found, e.g. "NewsArticle". Empty if the date is not found in those or it has no type.
+	EntityType string
+	// EntityID is the @id of JSON-LD entity (or `itemid` of microdata item) where the date
+	// found, if any.
+	EntityID string
+	// Relative reports whether the date is resolved from relative expression, e.g. "3 hours
+	// ago", so it's only as accurate as `Options.Now`.
+	Relative bool
+	// Calendar is the calendar system of the source string. DateTime is always converted
+	// into Gregorian, e.g. "۲۲ تیر ۱۴۰۰" in Jalali calendar becomes 2021-07-13.
+	Calendar Calendar
+	// AmbiguousDateOrder reports whether the date is written as numeric date where both day
+	// and month are at most 12 (e.g. 03/04/2021), and nothing in the page tells whether it's
+	// day or month first. In that case the day is assumed to come first.
+	AmbiguousDateOrder bool
 }
 
 // IsZero reports whether the result is empty or not.
@@ -28,3 +53,11 @@ func (r Result) IsZero() bool {
 func (r Result) Format(layout string) string {
 	return r.DateTime.Format(layout)
 }
+
+// Dates is the result of extracting both the original and the modified date.
+type Dates struct {
+	// Published is the original publish date of the page.
+	Published Result
+	// Modified is the last modified date of the page.
+	Modified Result
+}
@@ -0,0 +1,284 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"time"
+
+	"github.com/go-shiori/dom"
+	"github.com/markusmobius/go-htmldate/internal/re2go"
+	"github.com/markusmobius/go-htmldate/internal/selector"
+	"golang.org/x/net/html"
+)
+
+// Stage is a single step for looking the date in the document.
+type Stage interface {
+	// Method returns the extraction method used by this stage.
+	Method() Method
+	// Find looks for the date in the page. It returns the raw string where the date
+	// found, or zero time if date is not found. The found date must be already
+	// validated, e.g. using `Options.MinDate` and `Options.MaxDate`.
+	Find(page *Page, opts Options) (string, time.Time)
+}
+
+// Page is the HTML document that being processed. It keeps the intermediate forms of
+// the document which shared by the extraction stages, so each of them only computed once.
+// It's only valid during a single extraction, and must not be modified by the stages.
+type Page struct {
+	doc        *html.Node
+	prunedDoc  *html.Node
+	htmlString *string
+	jsonTexts  []jsonCapturedText
+	jsonParsed bool
+}
+
+// Document returns the full document.
+func (p *Page) Document() *html.Node {
+	return p.doc
+}
+
+// Pruned returns the document that has been cleaned from the unwanted elements.
+func (p *Page) Pruned() *html.Node {
+	if p.prunedDoc == nil {
+		p.prunedDoc = cleanDocument(p.doc)
+		discardUnwanted(p.prunedDoc)
+	}
+	return p.prunedDoc
+}
+
+// HTML returns the pruned document as HTML string.
+func (p *Page) HTML() string {
+	if p.htmlString == nil {
+		var htmlString string
+		prunedDoc := p.Pruned()
+		htmlNode := dom.QuerySelector(prunedDoc, "html")
+		if htmlNode != nil {
+			htmlString = dom.InnerHTML(htmlNode)
+		} else {
+			htmlString = dom.InnerHTML(prunedDoc)
+		}
+		p.htmlString = &htmlString
+	}
+	return *p.htmlString
+}
+
+// jsonDateTexts returns the date texts found in JSON sections of the document.
+func (p *Page) jsonDateTexts(opts Options) []jsonCapturedText {
+	if !p.jsonParsed {
+		p.jsonTexts = findJsonDateTexts(p.doc, opts)
+		p.jsonParsed = true
+	}
+	return p.jsonTexts
+}
+
+// funcStage is stage that implemented by a function.
+type funcStage struct {
+	method Method
+	find   func(p *Page, opts Options) (string, time.Time)
+}
+
+// NewStage creates a stage from the specified function. Use `MethodCustom` as method
+// unless the function replaces one of the built-in method.
+func NewStage(method Method, find func(page *Page, opts Options) (string, time.Time)) Stage {
+	return funcStage{method: method, find: find}
+}
+
+func (s funcStage) Method() Method {
+	return s.method
+}
+
+func (s funcStage) Find(p *Page, opts Options) (string, time.Time) {
+	return s.find(p, opts)
+}
+
+// Built-in stages, listed in their default order.
+var (
+	// StageUrl looks for the date in page URL. Skipped if `DeferUrlExtractor` is enabled.
+	StageUrl = NewStage(MethodUrl, func(p *Page, opts Options) (string, time.Time) {
+		// If not deferred, check URL first
+		if opts.URL == "" || opts.DeferUrlExtractor {
+			return "", timeZero
+		}
+		return opts.URL, extractUrlDate(opts.URL, opts)
+	})
+
+	// StageMeta looks for the date in <meta> elements.
+	StageMeta = NewStage(MethodMeta, func(p *Page, opts Options) (string, time.Time) {
+		// Try from head elements
+		return examineMetaElements(p.doc, opts)
+	})
+
+	// StageJson looks for the date in JSON-LD and settings JSON scripts.
+	StageJson = NewStage(MethodJson, func(p *Page, opts Options) (string, time.Time) {
+		// Try to use JSON data
+		return selectJsonDate(p.jsonDateTexts(opts), opts)
+	})
+
+	// StageMicrodata looks for the date in microdata and RDFa properties of any element.
+	StageMicrodata = NewStage(MethodMicrodata, func(p *Page, opts Options) (string, time.Time) {
+		// Try item properties outside <meta>
+		return examineMicrodata(p.doc, opts)
+	})
+
+	// StageHydration looks for the date in hydration data of JavaScript frameworks, e.g.
+	// `__NEXT_DATA__` of Next.js or `window.__INITIAL_STATE__`.
+	StageHydration = NewStage(MethodHydration, func(p *Page, opts Options) (string, time.Time) {
+		// Try data of single page applications
+		return hydrationSearch(p.doc, opts)
+	})
+
+	// StageAnalytics looks for the date in JavaScript object literals of analytics
+	// snippets, e.g. `dataLayer.push({...})` or `utag_data = {...}`.
+	StageAnalytics = NewStage(MethodAnalytics, func(p *Page, opts Options) (string, time.Time) {
+		// Try data that pushed to analytics and tag managers
+		return analyticsSearch(p.doc, opts)
+	})
+
+	// StageDeferredUrl looks for the date in page URL. Only used if `DeferUrlExtractor`
+	// is enabled.
+	StageDeferredUrl = NewStage(MethodUrl, func(p *Page, opts Options) (string, time.Time) {
+		// If deferred, process URL here (may be moved even further down if necessary)
+		if opts.URL == "" || !opts.DeferUrlExtractor {
+			return "", timeZero
+		}
+		return opts.URL, extractUrlDate(opts.URL, opts)
+	})
+
+	// StageAbbr looks for the date in <abbr> elements.
+	StageAbbr = NewStage(MethodAbbr, func(p *Page, opts Options) (string, time.Time) {
+		// Try <abbr> elements
+		return examineAbbrElements(p.doc, opts)
+	})
+
+	// StageSelector looks for the date in text of elements that likely contain date.
+	StageSelector = NewStage(MethodSelector, func(p *Page, opts Options) (string, time.Time) {
+		// Define selectors + text content
+		var dateSelector selector.Rule
+		if !opts.SkipExtensiveSearch {
+			dateSelector = selector.SlowDate
+		} else {
+			dateSelector = selector.FastDate
+		}
+
+		// Then look for expressions
+		dateElements := selector.QueryAll(p.Pruned(), dateSelector)
+		return examineOtherElements(dateElements, MethodSelector, opts)
+	})
+
+	// StageTitle looks for the date in <title> and <h1> elements.
+	StageTitle = NewStage(MethodTitle, func(p *Page, opts Options) (string, time.Time) {
+		// Try title elements
+		titleElements := dom.QuerySelectorAll(p.Pruned(), "title, h1")
+		return examineOtherElements(titleElements, MethodTitle, opts)
+	})
+
+	// StageTime looks for the date in <time> elements.
+	StageTime = NewStage(MethodTime, func(p *Page, opts Options) (string, time.Time) {
+		// Try <time> elements
+		return examineTimeElements(p.Pruned(), opts)
+	})
+
+	// StageEpoch looks for epoch timestamp in data attributes of any element, e.g.
+	// `data-timestamp` or `data-publish-date`.
+	StageEpoch = NewStage(MethodEpoch, func(p *Page, opts Options) (string, time.Time) {
+		// Try timestamps in data attributes
+		return examineEpochAttributes(p.Pruned(), opts)
+	})
+
+	// StageTimestamp looks for timestamp pattern in page HTML.
+	StageTimestamp = NewStage(MethodTimestamp, func(p *Page, opts Options) (string, time.Time) {
+		// String search using regex timestamp
+		return regexPatternSearch(p.HTML(), "Timestamp",
+			re2go.TimestampPatternSubmatch, opts)
+	})
+
+	// StageMetaImage looks for the date in URL of og:image.
+	StageMetaImage = NewStage(MethodMetaImage, func(p *Page, opts Options) (string, time.Time) {
+		// Try URL from image metadata
+		return metaImgSearch(p.Pruned(), opts)
+	})
+
+	// StageIdiosyncrasy looks for author-written date expressions in page HTML.
+	StageIdiosyncrasy = NewStage(MethodIdiosyncrasy, func(p *Page, opts Options) (string, time.Time) {
+		// Precise patterns and idiosyncrasies
+		return idiosyncrasiesSearch(p.HTML(), opts)
+	})
+
+	// StageFreeText looks for the date in free text of the document. Skipped if
+	// `SkipExtensiveSearch` is enabled.
+	StageFreeText = NewStage(MethodFreeText, func(p *Page, opts Options) (string, time.Time) {
+		// Last resort: do extensive search.
+		if opts.SkipExtensiveSearch {
+			return "", timeZero
+		}
+		return extensiveSearch(p.Pruned(), opts)
+	})
+
+	// StageSearchPage opportunistically looks for date patterns in page HTML. Skipped
+	// if `SkipExtensiveSearch` is enabled.
+	StageSearchPage = NewStage(MethodSearchPage, func(p *Page, opts Options) (string, time.Time) {
+		// Search page HTML
+		if opts.SkipExtensiveSearch {
+			return "", timeZero
+		}
+		return searchPage(p.HTML(), opts)
+	})
+)
+
+// DefaultPipeline returns the default stages for looking the date, sorted by its
+// priority. The returned slice is a new copy, so it's safe to be modified.
+func DefaultPipeline() []Stage {
+	return []Stage{
+		StageUrl,
+		StageMeta,
+		StageJson,
+		StageMicrodata,
+		StageHydration,
+		StageAnalytics,
+		StageDeferredUrl,
+		StageAbbr,
+		StageSelector,
+		StageTitle,
+		StageTime,
+		StageEpoch,
+
+		// TODO: for now, we'll stop searching in discarded elements
+		// Search in the discarded elements (currently: footers and archive.org banner)
+		// for _, subTree := range discarded {
+		// 	dateElements := htmlxpath.Find(subTree, dateXpathQuery)
+		// 	rawString, dateResult := examineOtherElements(dateElements, opts)
+		// 	if !dateResult.IsZero() {
+		// 		return rawString, dateResult, nil
+		// 	}
+		// }
+
+		StageTimestamp,
+		StageMetaImage,
+		StageIdiosyncrasy,
+		StageFreeText,
+		StageSearchPage,
+	}
+}
+
+// defaultPipeline is the default stages that used when pipeline is not specified.
+var defaultPipeline = DefaultPipeline()
+
+// pipeline returns the stages that used for looking the date.
+func (opts Options) pipeline() []Stage {
+	if opts.Pipeline != nil {
+		return opts.Pipeline
+	}
+	return defaultPipeline
+}
@@ -0,0 +1,76 @@
+package htmldate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-shiori/dom"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Pipeline(t *testing.T) {
+	str := `<html><head><title>Report of 2017-03-05</title></head><body>
+		<h1>Report of 2017-03-05</h1>
+		<time datetime="2017-04-06">April 6, 2017</time>
+	</body></html>`
+
+	// Helper function
+	extract := func(opts Options) Result {
+		res, err := FromReader(strings.NewReader(str), opts)
+		assert.NoError(t, err)
+		return res
+	}
+
+	// By default title is checked before <time>
+	res := extract(Options{})
+	assert.Equal(t, MethodTitle, res.Method)
+	assert.Equal(t, "2017-03-05", res.DateTime.Format("2006-01-02"))
+
+	// Run <time> before title
+	res = extract(Options{Pipeline: []Stage{StageTime, StageTitle}})
+	assert.Equal(t, MethodTime, res.Method)
+	assert.Equal(t, "2017-04-06", res.DateTime.Format("2006-01-02"))
+
+	// Remove every stages that could find the date
+	res = extract(Options{Pipeline: []Stage{StageMeta, StageJson}})
+	assert.True(t, res.IsZero())
+
+	// Empty pipeline should not find anything
+	res = extract(Options{Pipeline: []Stage{}})
+	assert.True(t, res.IsZero())
+
+	// Insert custom stage in front
+	customStage := NewStage(MethodCustom, func(page *Page, opts Options) (string, time.Time) {
+		elem := dom.QuerySelector(page.Document(), "time")
+		if elem == nil {
+			return "", timeZero
+		}
+
+		text := dom.TextContent(elem)
+		dt, _ := time.Parse("January 2, 2006", text)
+		return text, dt
+	})
+
+	res = extract(Options{Pipeline: append([]Stage{customStage}, DefaultPipeline()...)})
+	assert.Equal(t, MethodCustom, res.Method)
+	assert.Equal(t, "April 6, 2017", res.SrcString)
+	assert.Equal(t, "2017-04-06", res.DateTime.Format("2006-01-02"))
+
+	// Custom stage should be listed in candidates
+	doc, _ := dom.Parse(strings.NewReader(str))
+	candidates, err := FindCandidates(doc, Options{Pipeline: []Stage{customStage, StageTitle}})
+	assert.NoError(t, err)
+	assert.Len(t, candidates, 2)
+	assert.Equal(t, MethodCustom, candidates[0].Method)
+	assert.Equal(t, MethodTitle, candidates[1].Method)
+
+	// Nil stage is not allowed
+	_, err = NewExtractor(Options{Pipeline: []Stage{StageMeta, nil}})
+	assert.Error(t, err)
+
+	// Modifying default pipeline should not affect the extraction
+	pipeline := DefaultPipeline()
+	pipeline[0] = nil
+	assert.Equal(t, MethodTitle, extract(Options{}).Method)
+}
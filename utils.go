@@ -19,7 +19,9 @@ package htmldate
 
 import (
 	"bytes"
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -146,6 +148,87 @@ func normalizeSpaces(s string) string {
 	return strings.TrimSpace(s)
 }
 
+// normalizeDigits converts non-ASCII decimal digits (e.g. full-width ２０２１, Arabic-Indic
+// ٢٠٢١, Persian ۱۴۰۰ or Devanagari २०२१) into ASCII digits, since the regexes and the
+// number parsing only understand ASCII digits.
+func normalizeDigits(s string) string {
+	if !strings.ContainsFunc(s, isNonASCIIDigit) {
+		return s
+	}
+
+	return strings.Map(func(r rune) rune {
+		if isNonASCIIDigit(r) {
+			return '0' + digitValue(r)
+		}
+		return r
+	}, s)
+}
+
+func isNonASCIIDigit(r rune) bool {
+	return r >= utf8.RuneSelf && unicode.IsDigit(r)
+}
+
+// digitValue returns the value of a decimal digit. In Unicode, the decimal digits of
+// every script are encoded in contiguous runs from zero to nine.
+func digitValue(r rune) rune {
+	for _, rng := range unicode.Nd.R16 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return (r - rune(rng.Lo)) % 10
+		}
+	}
+
+	for _, rng := range unicode.Nd.R32 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return (r - rune(rng.Lo)) % 10
+		}
+	}
+
+	return 0
+}
+
+// normalizeRomanMonths converts dates with Roman numeral month (e.g. 13.VII.2021 or
+// 2021. VII. 13.) into ISO date (e.g. 2021-07-13), so they are parsed like the other
+// numeric dates. Since the month is unambiguous, they are always written year first.
+func normalizeRomanMonths(s string) string {
+	if !strings.ContainsAny(s, "IVX") {
+		return s
+	}
+
+	patterns := []struct {
+		rx       *regexp.Regexp
+		dayIdx   int
+		monthIdx int
+		yearIdx  int
+	}{
+		{rxRomanDMY, 2, 3, 4},
+		{rxRomanYMD, 4, 3, 2},
+	}
+
+	for _, pattern := range patterns {
+		var sb strings.Builder
+		var lastIdx int
+		for _, idxs := range pattern.rx.FindAllStringSubmatchIndex(s, -1) {
+			part := func(i int) string { return s[idxs[i*2]:idxs[i*2+1]] }
+			day, _ := strconv.Atoi(part(pattern.dayIdx))
+			month := romanMonths[part(pattern.monthIdx)]
+			if day < 1 || day > 31 {
+				continue
+			}
+
+			sb.WriteString(s[lastIdx:idxs[2]])
+			sb.WriteString(fmt.Sprintf("%s-%02d-%02d", part(pattern.yearIdx), month, day))
+			lastIdx = idxs[3]
+		}
+
+		if lastIdx > 0 {
+			sb.WriteString(s[lastIdx:])
+			s = sb.String()
+		}
+	}
+
+	return s
+}
+
 func rxFindNamedStringSubmatch(rx *regexp.Regexp, s string) (map[string]string, string) {
 	names := rx.SubexpNames()
 	result := make(map[string]string)
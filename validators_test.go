@@ -37,7 +37,7 @@ func Test_validateDate(t *testing.T) {
 
 		opts := Options{
 			MinDate: defaultMinDate,
-			MaxDate: defaultMaxDate,
+			MaxDate: testMaxDate,
 		}
 
 		if len(customOpts) > 0 {
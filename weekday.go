@@ -0,0 +1,177 @@
+// Copyright (C) 2022 Markus Mobius
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmldate
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+var rxWord = regexp.MustCompile(`[\pL\pM][\pL\pM'ʼ-]*`)
+
+// allLexicons is every lexicons sorted by its language, used to look for the weekday
+// names when the languages of the page is unknown.
+var allLexicons = func() []*lexicon {
+	var languages []string
+	for lang := range lexicons {
+		languages = append(languages, lang)
+	}
+
+	slices.Sort(languages)
+	lexs := make([]*lexicon, len(languages))
+	for i, lang := range languages {
+		lexs[i] = lexicons[lang]
+	}
+	return lexs
+}()
+
+// statedWeekday looks for the weekday name that written along the date, e.g. "Tuesday"
+// in "Tuesday, 13/07/2021" or "Di." in "Di., 13.07.2021". Since the weekday names might
+// be common words or personal names (e.g. "Domingo"), they are only accepted when written
+// next to the date. The abbreviated names are also only accepted at the start of the
+// string or when followed by dot or comma, and only when the languages of the page is
+// known. Returns false if there are no weekday or the weekdays contradict each other.
+func statedWeekday(s string, opts Options) (time.Weekday, bool) {
+	var lexs []*lexicon
+	for _, lang := range opts.languages {
+		if lex, exist := lexicons[lang]; exist {
+			lexs = append(lexs, lex)
+		}
+	}
+
+	fullOnly := len(lexs) == 0
+	if fullOnly {
+		lexs = allLexicons
+	}
+
+	var found, adjacent bool
+	var weekday time.Weekday
+	s = strings.ToLower(s)
+	for i, loc := range rxWord.FindAllStringIndex(s, -1) {
+		word := s[loc[0]:loc[1]]
+		punctuated := strings.HasPrefix(s[loc[1]:], ".") || strings.HasPrefix(s[loc[1]:], ",")
+
+		for _, lex := range lexs {
+			wd, exist := lex.weekdays[word]
+			if !exist {
+				continue
+			}
+
+			if _, isAbbr := lex.weekdayAbbreviations[word]; isAbbr {
+				if fullOnly || (i > 0 && !punctuated) {
+					continue
+				}
+			}
+
+			if found && wd != weekday {
+				return 0, false
+			}
+
+			found, weekday = true, wd
+			adjacent = adjacent || isNextToDate(s, loc, lexs)
+			break
+		}
+	}
+
+	return weekday, found && adjacent
+}
+
+// isNextToDate checks if the word in the specified location is written next to the date,
+// i.e. it's only separated by punctuations and at most one short word (e.g. "den" in
+// "Dienstag, den 13. Juli") from a number or a month name.
+func isNextToDate(s string, loc []int, lexs []*lexicon) bool {
+	const separators = " \t\n\r,.;:()[]|/-–—"
+
+	// Date before the word
+	before := strings.TrimRight(s[:loc[0]], separators)
+	if r, _ := utf8.DecodeLastRuneInString(before); unicode.IsDigit(r) {
+		return true
+	}
+
+	// Date after the word, which might start with month name
+	after := strings.TrimLeft(s[loc[1]:], separators)
+	for i := 0; i < 2; i++ {
+		if r, _ := utf8.DecodeRuneInString(after); unicode.IsDigit(r) {
+			return true
+		}
+
+		wordLoc := rxWord.FindStringIndex(after)
+		if wordLoc == nil || wordLoc[0] != 0 {
+			return false
+		}
+
+		word := after[:wordLoc[1]]
+		if slices.ContainsFunc(lexs, func(lex *lexicon) bool { return lex.months[word] > 0 }) {
+			return true
+		}
+
+		if utf8.RuneCountInString(word) > 3 {
+			return false
+		}
+		after = strings.TrimLeft(after[wordLoc[1]:], separators)
+	}
+
+	return false
+}
+
+// checkWeekday validates the date against the weekday that stated in the string. If they
+// contradict each other and the page language is known, the date is read in the other day
+// and month order, or rejected if that doesn't help. Otherwise the weekday is ignored.
+func checkWeekday(s string, date time.Time, opts Options) time.Time {
+	if date.IsZero() {
+		return date
+	}
+
+	// Weekday is only trusted to change the date when the page language is known
+	weekday, found := statedWeekday(s, opts)
+	if !found || date.Weekday() == weekday {
+		return date
+	}
+
+	if len(opts.languages) == 0 {
+		opts.log.Debugf("weekday mismatch ignored since page language is unknown: %s", s)
+		return date
+	}
+
+	// Only numeric date could be read in the other order
+	if isAmbiguousDate(s, date) {
+		year, month, day := date.Date()
+		swapped, valid := validateDateParts(year, day, int(month), opts)
+		if valid && swapped.Weekday() == weekday {
+			opts.log.Debugf("date swapped to match weekday: %s", s)
+			return swapped
+		}
+	}
+
+	opts.log.Debugf("date rejected since weekday doesn't match: %s", s)
+	return timeZero
+}
+
+// weekdayDecidesOrder checks if the weekday stated in the string tells the order of day
+// and month of the date, i.e. it matches the date but not the swapped one.
+func weekdayDecidesOrder(s string, date time.Time, opts Options) bool {
+	weekday, found := statedWeekday(s, opts)
+	if !found || date.Weekday() != weekday {
+		return false
+	}
+
+	year, month, day := date.Date()
+	swapped := time.Date(year, time.Month(day), int(month), 0, 0, 0, 0, time.UTC)
+	return swapped.Weekday() != weekday
+}
@@ -0,0 +1,116 @@
+package htmldate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_statedWeekday(t *testing.T) {
+	// Helper function
+	check := func(expected time.Weekday, expectedFound bool, s string, languages ...string) {
+		weekday, found := statedWeekday(s, Options{languages: languages})
+		assert.Equal(t, expectedFound, found, s)
+		if expectedFound {
+			assert.Equal(t, expected, weekday, s)
+		}
+	}
+
+	check(time.Tuesday, true, "Tuesday, 13/07/2021")
+	check(time.Tuesday, true, "Published on Tuesday 13 July 2021")
+	check(time.Tuesday, true, "Tue, 13 Jul 2021", "en")
+	check(time.Tuesday, true, "Di., 13.07.2021", "de")
+	check(time.Tuesday, true, "Dienstag, 13. Juli 2021")
+	check(time.Tuesday, true, "wtorek, 13 lipca 2021", "pl")
+	check(time.Tuesday, true, "вт, 13.07.2021", "ru")
+	check(time.Tuesday, true, "terça-feira, 13 de julho de 2021")
+
+	// Abbreviations are ignored if language is unknown
+	check(0, false, "Di., 13.07.2021")
+	check(0, false, "Tue, 13 Jul 2021")
+
+	// Abbreviations in the middle of text must be punctuated
+	check(0, false, "Das war so 13.07.2021", "de")
+	check(time.Sunday, true, "Am So. 11.07.2021", "de")
+
+	// Conflicting or missing weekdays
+	check(0, false, "Monday or Tuesday, 13/07/2021")
+	check(0, false, "13/07/2021")
+
+	// Weekday must be written next to the date
+	check(time.Tuesday, true, "Tuesday, July 13, 2021")
+	check(time.Tuesday, true, "13.07.2021 (Dienstag)")
+	check(time.Tuesday, true, "Dienstag, den 13. Juli 2021", "de")
+	check(0, false, "Por Domingo Pérez, 13/07/2021")
+	check(0, false, "Segunda edição - 13.07.2021")
+	check(0, false, "Minggu lalu kami menulis 13/07/2021")
+	check(0, false, "Quinta da Regaleira, 13.07.2021", "pt")
+}
+
+func Test_checkWeekday(t *testing.T) {
+	// Helper function
+	try := func(s string, languages ...string) string {
+		opts := Options{
+			MinDate:             defaultMinDate,
+			MaxDate:             testMaxDate,
+			SkipExtensiveSearch: true,
+			languages:           languages,
+		}
+
+		_, dt := tryDateExpr(s, opts)
+		if !dt.IsZero() {
+			return dt.Format("2006-01-02")
+		}
+		return ""
+	}
+
+	// Weekday confirms the date
+	assert.Equal(t, "2021-07-03", try("Saturday, 03/07/2021"))
+	assert.Equal(t, "2021-07-13", try("Di., 13.07.2021", "de"))
+	assert.Equal(t, "2021-07-13", try("Tuesday, July 13, 2021"))
+
+	// Weekday swaps day and month
+	assert.Equal(t, "2021-03-07", try("Sunday, 03/07/2021", "en"))
+	assert.Equal(t, "2021-03-07", try("So., 03.07.2021", "de"))
+
+	// Weekday rejects the date
+	assert.Equal(t, "", try("Tuesday, 03/07/2021", "en"))
+	assert.Equal(t, "", try("Mi., 13.07.2021", "de"))
+	assert.Equal(t, "", try("Wednesday, July 13, 2021", "en"))
+
+	// Mismatch is ignored if language is unknown or weekday is not next to the date
+	assert.Equal(t, "2021-07-03", try("Tuesday, 03/07/2021"))
+	assert.Equal(t, "2021-07-13", try("Wednesday, July 13, 2021"))
+	assert.Equal(t, "2021-07-13", try("Por Domingo Pérez, 13/07/2021"))
+	assert.Equal(t, "2021-07-13", try("Segunda edição - 13.07.2021"))
+	assert.Equal(t, "2021-07-13", try("Por Domingo Pérez, 13/07/2021", "es"))
+	assert.Equal(t, "2021-07-13", try("Segunda edição - 13.07.2021", "pt"))
+
+	// Unknown abbreviation is not used
+	assert.Equal(t, "2021-07-13", try("Mi., 13.07.2021"))
+}
+
+func Test_WeekdayDateOrder(t *testing.T) {
+	result, err := FromReader(strings.NewReader(`<html lang="en"><body>
+		<p class="date">Sunday, 03/07/2021</p>
+	</body></html>`), Options{SkipExtensiveSearch: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-03-07", result.Format("2006-01-02"))
+	assert.False(t, result.AmbiguousDateOrder)
+}
+
+func Test_WeekdayFalsePositives(t *testing.T) {
+	// Helper function
+	check := func(expected string, str string) {
+		result, err := FromReader(strings.NewReader(str), Options{SkipExtensiveSearch: true})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result.Format("2006-01-02"), str)
+	}
+
+	check("2021-07-13", `<html><body><p class="date">Por Domingo Pérez, 13/07/2021</p></body></html>`)
+	check("2021-07-13", `<html><body><p class="date">Segunda edição - 13.07.2021</p></body></html>`)
+	check("2021-07-13", `<html lang="es"><body><p class="date">Por Domingo Pérez, 13/07/2021</p></body></html>`)
+	check("2021-07-13", `<html lang="pt"><body><p class="date">Segunda edição - 13.07.2021</p></body></html>`)
+}